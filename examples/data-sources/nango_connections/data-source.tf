@@ -0,0 +1,15 @@
+data "nango_connections" "stripe" {
+  provider_config_key = "stripe"
+}
+
+output "stripe_connection_ids" {
+  value = [for connection in data.nango_connections.stripe.connections : connection.connection_id]
+}
+
+data "nango_connections" "acme" {
+  organization_id = "acme"
+}
+
+output "acme_plans" {
+  value = { for connection in data.nango_connections.acme.connections : connection.connection_id => jsondecode(connection.metadata).plan }
+}
@@ -0,0 +1,7 @@
+data "nango_integration" "google" {
+  unique_key = "google-oauth"
+}
+
+output "google_webhook_url" {
+  value = data.nango_integration.google.webhook_url
+}
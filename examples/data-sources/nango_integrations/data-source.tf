@@ -7,3 +7,12 @@ output "integration_count" {
 output "integration_names" {
   value = [for integration in data.nango_integrations.all.integrations : integration.display_name]
 }
+
+data "nango_integrations" "google" {
+  nango_provider    = "google"
+  unique_key_prefix = "google-"
+}
+
+output "google_scopes" {
+  value = { for integration in data.nango_integrations.google.integrations : integration.unique_key => integration.credentials.scopes }
+}
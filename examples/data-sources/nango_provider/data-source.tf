@@ -0,0 +1,8 @@
+data "nango_provider" "zendesk" {
+  name = "zendesk"
+}
+
+# For example: ["subdomain"]
+output "zendesk_required_config" {
+  value = [for field in data.nango_provider.zendesk.connection_config : field.name if field.required]
+}
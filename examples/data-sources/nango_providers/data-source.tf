@@ -0,0 +1,17 @@
+data "nango_providers" "oauth2_crm" {
+  auth_mode = "OAUTH2"
+  category  = "crm"
+}
+
+output "crm_providers" {
+  value = [for provider in data.nango_providers.oauth2_crm.providers : provider.name]
+}
+
+variable "nango_provider" {
+  type = string
+
+  validation {
+    condition     = contains([for provider in data.nango_providers.oauth2_crm.providers : provider.name], var.nango_provider)
+    error_message = "Unknown Nango provider."
+  }
+}
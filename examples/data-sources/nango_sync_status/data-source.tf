@@ -0,0 +1,8 @@
+data "nango_sync_status" "calendar" {
+  provider_config_key = "google-calendar"
+  syncs               = ["events"]
+}
+
+output "failed_syncs" {
+  value = [for sync in data.nango_sync_status.calendar.statuses : sync.connection_id if sync.status == "ERROR"]
+}
@@ -0,0 +1,20 @@
+ephemeral "nango_connect_session" "onboarding" {
+  end_user = {
+    id    = "user-42"
+    email = "jane@acme.example"
+  }
+
+  organization = {
+    id           = "acme"
+    display_name = "Acme Inc."
+  }
+
+  allowed_integrations = ["google-calendar", "slack"]
+}
+
+# The token can only be referenced from other ephemeral contexts, such as
+# provider configuration, write-only arguments or ephemeral module outputs.
+output "connect_session_token" {
+  value     = ephemeral.nango_connect_session.onboarding.token
+  ephemeral = true
+}
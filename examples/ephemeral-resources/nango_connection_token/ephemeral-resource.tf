@@ -0,0 +1,9 @@
+ephemeral "nango_connection_token" "github" {
+  connection_id       = "acme"
+  provider_config_key = "github"
+}
+
+provider "github" {
+  owner = "acme"
+  token = ephemeral.nango_connection_token.github.access_token
+}
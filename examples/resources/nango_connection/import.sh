@@ -0,0 +1,2 @@
+# Connections are imported by <provider_config_key>/<connection_id>.
+terraform import nango_connection.acme stripe/acme
@@ -0,0 +1,19 @@
+resource "nango_connection" "acme" {
+  connection_id       = "acme"
+  provider_config_key = nango_integration.stripe.unique_key
+
+  credentials = {
+    type    = "API_KEY"
+    api_key = var.stripe_api_key
+  }
+
+  end_user = {
+    id    = "user-42"
+    email = "jane@acme.example"
+  }
+
+  organization = {
+    id           = "acme"
+    display_name = "Acme Inc."
+  }
+}
@@ -0,0 +1,3 @@
+# Connection metadata is imported by <provider_config_key>/<connection_id>.
+# Every key the connection has at import time becomes managed.
+terraform import nango_connection_metadata.acme_calendars google-calendar/acme
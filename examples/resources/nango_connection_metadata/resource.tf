@@ -0,0 +1,9 @@
+resource "nango_connection_metadata" "acme_calendars" {
+  connection_id       = nango_connection.acme.connection_id
+  provider_config_key = nango_connection.acme.provider_config_key
+
+  metadata = jsonencode({
+    calendars = ["primary", "team@acme.example"]
+    sync_past = "30d"
+  })
+}
@@ -0,0 +1,3 @@
+# Environment settings are imported by the name of the environment that the
+# provider's environment_key belongs to.
+terraform import nango_environment_settings.this prod
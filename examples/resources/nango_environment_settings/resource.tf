@@ -0,0 +1,11 @@
+resource "nango_environment_settings" "this" {
+  callback_url          = "https://auth.example.com/oauth/callback"
+  webhook_url           = "https://api.example.com/webhooks/nango"
+  secondary_webhook_url = ""
+
+  send_auth_webhook   = true
+  always_send_webhook = false
+
+  hmac_enabled = true
+  hmac_key     = var.nango_hmac_key
+}
@@ -0,0 +1,2 @@
+# Environment variables are imported by name.
+terraform import nango_environment_variable.api_base_url API_BASE_URL
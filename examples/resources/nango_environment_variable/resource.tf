@@ -0,0 +1,9 @@
+resource "nango_environment_variable" "api_base_url" {
+  name  = "API_BASE_URL"
+  value = "https://api.example.com"
+}
+
+resource "nango_environment_variable" "signing_secret" {
+  name  = "SIGNING_SECRET"
+  value = var.signing_secret
+}
@@ -0,0 +1,2 @@
+# Integrations are imported by their unique_key.
+terraform import nango_integration.google google-oauth
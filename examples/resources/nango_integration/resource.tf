@@ -4,12 +4,31 @@ resource "nango_integration" "google" {
   nango_provider = "google"
 
   credentials = {
-    client_id     = var.google_client_id
-    client_secret = var.google_client_secret
-    type          = "OAUTH2"
-    scopes = [
-      "https://www.googleapis.com/auth/userinfo.email",
-      "https://www.googleapis.com/auth/userinfo.profile"
-    ]
+    oauth2 = {
+      client_id     = var.google_client_id
+      client_secret = var.google_client_secret
+      scopes = [
+        "https://www.googleapis.com/auth/userinfo.email",
+        "https://www.googleapis.com/auth/userinfo.profile"
+      ]
+    }
+  }
+}
+
+# The client secret can be kept out of plan and state with the write-only
+# client_secret_wo. Change client_secret_wo_version to send a new secret;
+# rotation_mode sends it on its own and verifies that Nango uses it.
+resource "nango_integration" "github" {
+  unique_key     = "github"
+  display_name   = "GitHub"
+  nango_provider = "github"
+  rotation_mode  = true
+
+  credentials = {
+    oauth2 = {
+      client_id                = var.github_client_id
+      client_secret_wo         = var.github_client_secret
+      client_secret_wo_version = 1
+    }
   }
 }
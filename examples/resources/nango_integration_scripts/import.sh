@@ -0,0 +1,3 @@
+# Scripts are imported by the integration's unique_key. The scripts enabled
+# at import time become managed.
+terraform import nango_integration_scripts.google_calendar google-calendar
@@ -0,0 +1,6 @@
+resource "nango_integration_scripts" "google_calendar" {
+  provider_config_key = nango_integration.google_calendar.unique_key
+
+  syncs   = ["events", "calendars"]
+  actions = ["create-event"]
+}
@@ -0,0 +1,4 @@
+# A sync schedule is imported by <provider_config_key>/<connection_id>/<syncs>,
+# with the sync names separated by commas. The frequency override is not
+# imported; set it in the configuration to apply it again.
+terraform import nango_sync_schedule.acme_calendar google-calendar/acme/events,calendars
@@ -0,0 +1,11 @@
+resource "nango_sync_schedule" "acme_calendar" {
+  provider_config_key = "google-calendar"
+  connection_id       = "acme"
+  syncs               = ["events", "calendars"]
+
+  paused    = false
+  frequency = "every 15 minutes"
+
+  # Change this value to run the syncs once, e.g. after a metadata change.
+  trigger = nango_connection_metadata.acme_calendars.metadata
+}
@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package nango is a small typed client for the Nango REST API.
+package nango
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// DefaultBaseURL is the Nango Cloud API endpoint.
+const DefaultBaseURL = "https://api.nango.dev"
+
+// Client talks to the Nango API on behalf of a single environment.
+type Client struct {
+	httpClient *retryablehttp.Client
+	baseURL    string
+
+	// providers caches the catalog entries returned by GetProvider. The
+	// catalog only changes with Nango releases.
+	providersMu sync.Mutex
+	providers   map[string]Provider
+}
+
+// NewClient returns a Client for the given base URL, authenticating every
+// request with the environment's secret key.
+func NewClient(baseURL, environmentKey string) *Client {
+	retryClient := retryablehttp.NewClient()
+	retryClient.RetryMax = 3                          // Maximum retry attempts
+	retryClient.RetryWaitMin = 1 * time.Second        // Minimum wait time between retries
+	retryClient.RetryWaitMax = 5 * time.Second        // Maximum wait time between retries
+	retryClient.HTTPClient.Timeout = 30 * time.Second // Set the timeout for the HTTP client
+	// Hand the last response back once retries are exhausted so that 429 and
+	// 5xx errors are decoded like any other API error.
+	retryClient.ErrorHandler = retryablehttp.PassthroughErrorHandler
+	retryClient.HTTPClient.Transport = &authTransport{authKey: environmentKey, next: retryClient.HTTPClient.Transport}
+
+	return NewClientWithHTTPClient(baseURL, retryClient)
+}
+
+// NewClientWithHTTPClient returns a Client that sends requests through the
+// given retryable HTTP client. Authentication is left to the caller, which
+// makes it convenient for pointing at an httptest server.
+func NewClientWithHTTPClient(baseURL string, httpClient *retryablehttp.Client) *Client {
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+	return &Client{
+		httpClient: httpClient,
+		baseURL:    strings.TrimRight(baseURL, "/"),
+	}
+}
+
+// BaseURL returns the API endpoint the client sends requests to.
+func (c *Client) BaseURL() string {
+	return c.baseURL
+}
+
+// do sends a request and decodes a JSON response into out. A nil body sends
+// no payload and a nil out discards the response. Any non-2xx response is
+// returned as an *APIError.
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, body, out any) error {
+	endpoint := c.baseURL + path
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+
+	var payload any
+	if body != nil {
+		requestBody, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("encoding request body: %w", err)
+		}
+		payload = requestBody
+	}
+
+	req, err := retryablehttp.NewRequestWithContext(ctx, method, endpoint, payload)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	responseBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("reading response body: %w", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return newAPIError(method, path, resp.StatusCode, responseBody)
+	}
+
+	if out == nil || len(bytes.TrimSpace(responseBody)) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(responseBody, out); err != nil {
+		return fmt.Errorf("decoding %s %s response: %w", method, path, err)
+	}
+	return nil
+}
+
+// authTransport adds the environment key to every request and logs the
+// method, URL and status of each exchange for TF_LOG debugging. Bodies are
+// never logged: they carry client secrets, tokens and other credentials.
+type authTransport struct {
+	authKey string
+	next    http.RoundTripper
+}
+
+func (t *authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", t.authKey))
+	startTime := time.Now()
+
+	log.Printf("Request: %s %s", req.Method, req.URL.String())
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		log.Printf("Error: %v", err)
+		return nil, err
+	}
+
+	elapsedTime := time.Since(startTime)
+	log.Printf("Response: %s %s - %d in %s", req.Method, req.URL.String(), resp.StatusCode, elapsedTime)
+
+	return resp, nil
+}
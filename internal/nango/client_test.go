@@ -0,0 +1,266 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package nango
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// newTestClient starts an httptest server with handler and returns a client
+// pointed at it that retries quickly.
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	retryClient := retryablehttp.NewClient()
+	retryClient.Logger = nil
+	retryClient.RetryMax = 2
+	retryClient.RetryWaitMin = time.Millisecond
+	retryClient.RetryWaitMax = time.Millisecond
+	retryClient.ErrorHandler = retryablehttp.PassthroughErrorHandler
+
+	return NewClientWithHTTPClient(server.URL, retryClient)
+}
+
+func TestDoSendsAndDecodesJSON(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/things" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if got := r.Header.Get("Content-Type"); got != "application/json" {
+			t.Errorf("Content-Type = %q, want application/json", got)
+		}
+		if got := r.Header.Get("Accept"); got != "application/json" {
+			t.Errorf("Accept = %q, want application/json", got)
+		}
+
+		var body map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("decoding request body: %v", err)
+		}
+		if body["name"] != "thing" {
+			t.Errorf("request body name = %q, want thing", body["name"])
+		}
+
+		_, _ = io.WriteString(w, `{"id": 42}`)
+	})
+
+	var out struct {
+		ID int `json:"id"`
+	}
+	err := client.do(context.Background(), http.MethodPost, "/things", nil, map[string]string{"name": "thing"}, &out)
+	if err != nil {
+		t.Fatalf("do: %v", err)
+	}
+	if out.ID != 42 {
+		t.Errorf("id = %d, want 42", out.ID)
+	}
+}
+
+func TestDoWithoutBody(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Content-Type"); got != "" {
+			t.Errorf("Content-Type = %q, want none", got)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	var out struct{}
+	if err := client.do(context.Background(), http.MethodDelete, "/things/1", nil, nil, &out); err != nil {
+		t.Fatalf("do: %v", err)
+	}
+}
+
+func TestDoReturnsAPIError(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = io.WriteString(w, `{"error": {"code": "invalid_body", "message": "Invalid request"}}`)
+	})
+
+	err := client.do(context.Background(), http.MethodGet, "/things", nil, nil, nil)
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("err = %v, want *APIError", err)
+	}
+	if apiErr.StatusCode != http.StatusBadRequest || apiErr.Code != "invalid_body" || apiErr.Method != http.MethodGet || apiErr.Path != "/things" {
+		t.Errorf("unexpected APIError %+v", apiErr)
+	}
+}
+
+func TestDoInvalidJSONResponse(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, `not json`)
+	})
+
+	var out map[string]any
+	err := client.do(context.Background(), http.MethodGet, "/things", nil, nil, &out)
+	if err == nil {
+		t.Fatal("do: want decoding error, got nil")
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		t.Errorf("err = %v, want a decoding error rather than an APIError", err)
+	}
+}
+
+func TestDoEncodesQuery(t *testing.T) {
+	var query url.Values
+	var path string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		path = r.URL.EscapedPath()
+		_, _ = io.WriteString(w, `{"data": {"unique_key": "my key/1"}}`)
+	})
+
+	if _, err := client.GetIntegration(context.Background(), "my key/1", IncludeWebhook, IncludeCredentials); err != nil {
+		t.Fatalf("GetIntegration: %v", err)
+	}
+	if path != "/integrations/my%20key%2F1" {
+		t.Errorf("path = %q, want the key escaped", path)
+	}
+	if got := query["include"]; len(got) != 2 || got[0] != IncludeWebhook || got[1] != IncludeCredentials {
+		t.Errorf("include = %v, want [%s %s]", got, IncludeWebhook, IncludeCredentials)
+	}
+}
+
+func TestDoRetriesAndPassesThroughLastResponse(t *testing.T) {
+	var calls atomic.Int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = io.WriteString(w, `{"error": "Service unavailable", "type": "unavailable"}`)
+	})
+
+	err := client.do(context.Background(), http.MethodGet, "/things", nil, nil, nil)
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("err = %v, want *APIError", err)
+	}
+	if apiErr.StatusCode != http.StatusServiceUnavailable || apiErr.Message != "Service unavailable" {
+		t.Errorf("unexpected APIError %+v", apiErr)
+	}
+	if got := calls.Load(); got != 3 {
+		t.Errorf("server called %d times, want 3", got)
+	}
+}
+
+func TestDoRetrySucceeds(t *testing.T) {
+	var calls atomic.Int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = io.WriteString(w, `{"ok": true}`)
+	})
+
+	var out struct {
+		OK bool `json:"ok"`
+	}
+	if err := client.do(context.Background(), http.MethodGet, "/things", nil, nil, &out); err != nil {
+		t.Fatalf("do: %v", err)
+	}
+	if !out.OK || calls.Load() != 2 {
+		t.Errorf("ok = %t after %d calls, want true after 2", out.OK, calls.Load())
+	}
+}
+
+func TestNewClientAuthenticates(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer secret-key" {
+			t.Errorf("Authorization = %q, want Bearer secret-key", got)
+		}
+		_, _ = io.WriteString(w, `{"data": []}`)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL+"/", "secret-key")
+	if client.BaseURL() != server.URL {
+		t.Errorf("BaseURL = %q, want %q", client.BaseURL(), server.URL)
+	}
+	if _, err := client.ListIntegrations(context.Background()); err != nil {
+		t.Fatalf("ListIntegrations: %v", err)
+	}
+}
+
+func TestTransportDoesNotLogSecrets(t *testing.T) {
+	var logged bytes.Buffer
+	previous := log.Writer()
+	log.SetOutput(&logged)
+	t.Cleanup(func() { log.SetOutput(previous) })
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost, http.MethodPatch:
+			_, _ = io.WriteString(w, `{"data": {"unique_key": "github", "credentials": {"type": "OAUTH2", "client_secret": "returned-client-secret"}}}`)
+		default:
+			_, _ = io.WriteString(w, `{"connection_id": "acme", "credentials": {"type": "OAUTH2", "access_token": "returned-access-token", "refresh_token": "returned-refresh-token"}}`)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "environment-secret-key")
+	_, err := client.CreateIntegration(context.Background(), CreateIntegrationRequest{
+		UniqueKey: "github",
+		Provider:  "github",
+		Credentials: &IntegrationCredentialsRequest{
+			Type:         "OAUTH2",
+			ClientID:     "client",
+			ClientSecret: "sent-client-secret",
+			PrivateKey:   "sent-private-key",
+		},
+	})
+	if err != nil {
+		t.Fatalf("CreateIntegration: %v", err)
+	}
+	// Rotating a write-only client_secret_wo sends it in a PATCH.
+	_, err = client.PatchIntegration(context.Background(), "github", PatchIntegrationRequest{
+		Credentials: &IntegrationCredentialsRequest{
+			Type:         "OAUTH2",
+			ClientID:     "client",
+			ClientSecret: "rotated-client-secret",
+		},
+	})
+	if err != nil {
+		t.Fatalf("PatchIntegration: %v", err)
+	}
+	if _, err := client.GetConnection(context.Background(), "acme", "github", GetConnectionOptions{RefreshToken: true}); err != nil {
+		t.Fatalf("GetConnection: %v", err)
+	}
+
+	if !strings.Contains(logged.String(), "Request: POST") {
+		t.Fatalf("expected the request to be logged, got %q", logged.String())
+	}
+	for _, secret := range []string{
+		"environment-secret-key",
+		"sent-client-secret",
+		"sent-private-key",
+		"rotated-client-secret",
+		"returned-client-secret",
+		"returned-access-token",
+		"returned-refresh-token",
+	} {
+		if strings.Contains(logged.String(), secret) {
+			t.Errorf("log output contains %q:\n%s", secret, logged.String())
+		}
+	}
+}
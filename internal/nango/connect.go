@@ -0,0 +1,35 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package nango
+
+import (
+	"context"
+	"net/http"
+)
+
+// CreateConnectSessionRequest is the body of POST /connect/sessions.
+type CreateConnectSessionRequest struct {
+	EndUser             EndUser       `json:"end_user"`
+	Organization        *Organization `json:"organization,omitempty"`
+	AllowedIntegrations []string      `json:"allowed_integrations,omitempty"`
+}
+
+// ConnectSession is a short-lived token the Connect UI authenticates with.
+type ConnectSession struct {
+	Token     string `json:"token"`
+	ExpiresAt string `json:"expires_at"`
+}
+
+type connectSessionResponse struct {
+	Data ConnectSession `json:"data"`
+}
+
+// CreateConnectSession creates a Connect session for an end user.
+func (c *Client) CreateConnectSession(ctx context.Context, req CreateConnectSessionRequest) (*ConnectSession, error) {
+	var out connectSessionResponse
+	if err := c.do(ctx, http.MethodPost, "/connect/sessions", nil, req, &out); err != nil {
+		return nil, err
+	}
+	return &out.Data, nil
+}
@@ -0,0 +1,225 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package nango
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// ConnectionSummary is a connection as listed by GET /connections.
+type ConnectionSummary struct {
+	ID                int64             `json:"id"`
+	ConnectionID      string            `json:"connection_id"`
+	ProviderConfigKey string            `json:"provider_config_key"`
+	Provider          string            `json:"provider"`
+	Created           string            `json:"created"`
+	Errors            []ConnectionError `json:"errors,omitempty"`
+	EndUser           *EndUser          `json:"end_user,omitempty"`
+	Metadata          map[string]any    `json:"metadata,omitempty"`
+}
+
+// ListConnectionsOptions filters GET /connections. Empty fields are not
+// sent.
+type ListConnectionsOptions struct {
+	// Search matches connection IDs and end user details.
+	Search string
+	// IntegrationID only returns connections of this integration.
+	IntegrationID string
+	// EndUserID only returns connections of this end user.
+	EndUserID string
+	// EndUserOrganizationID only returns connections of this organization.
+	EndUserOrganizationID string
+}
+
+// connectionsPageSize is the number of connections requested per page. The
+// server may cap it lower, so it is not used to detect the last page.
+const connectionsPageSize = 1000
+
+// Connection is a single connection as returned by GET /connection/{id}.
+type Connection struct {
+	ID                int64                  `json:"id"`
+	ConnectionID      string                 `json:"connection_id"`
+	ProviderConfigKey string                 `json:"provider_config_key"`
+	Provider          string                 `json:"provider"`
+	CreatedAt         string                 `json:"created_at"`
+	UpdatedAt         string                 `json:"updated_at"`
+	LastFetchedAt     string                 `json:"last_fetched_at,omitempty"`
+	Errors            []ConnectionError      `json:"errors,omitempty"`
+	EndUser           *EndUser               `json:"end_user,omitempty"`
+	Metadata          map[string]any         `json:"metadata,omitempty"`
+	ConnectionConfig  map[string]any         `json:"connection_config,omitempty"`
+	Credentials       *ConnectionCredentials `json:"credentials,omitempty"`
+}
+
+// ConnectionError is an error Nango recorded against a connection, for
+// example a failed token refresh (type "auth") or a failed sync.
+type ConnectionError struct {
+	Type  string `json:"type"`
+	LogID string `json:"log_id"`
+}
+
+// Connection error types.
+const (
+	ConnectionErrorAuth = "auth"
+	ConnectionErrorSync = "sync"
+)
+
+// EndUser identifies who a connection belongs to.
+type EndUser struct {
+	ID           string        `json:"id"`
+	Email        string        `json:"email,omitempty"`
+	DisplayName  string        `json:"display_name,omitempty"`
+	Organization *Organization `json:"organization,omitempty"`
+}
+
+// Organization identifies the end user's organization.
+type Organization struct {
+	ID          string `json:"id"`
+	DisplayName string `json:"display_name,omitempty"`
+}
+
+// ConnectionCredentials are the credentials Nango holds for a connection.
+// Which fields are set depends on Type.
+type ConnectionCredentials struct {
+	Type             string `json:"type"`
+	AccessToken      string `json:"access_token,omitempty"`
+	RefreshToken     string `json:"refresh_token,omitempty"`
+	ExpiresAt        string `json:"expires_at,omitempty"`
+	OAuthToken       string `json:"oauth_token,omitempty"`
+	OAuthTokenSecret string `json:"oauth_token_secret,omitempty"`
+	APIKey           string `json:"apiKey,omitempty"`
+	Username         string `json:"username,omitempty"`
+	Password         string `json:"password,omitempty"`
+}
+
+// ImportConnectionRequest is the body of POST /connection. Only the
+// credential fields used by the integration's auth mode should be set.
+// Posting an existing connection ID replaces its credentials.
+type ImportConnectionRequest struct {
+	ProviderConfigKey string            `json:"provider_config_key"`
+	ConnectionID      string            `json:"connection_id"`
+	ConnectionConfig  map[string]string `json:"connection_config,omitempty"`
+	AccessToken       string            `json:"access_token,omitempty"`
+	RefreshToken      string            `json:"refresh_token,omitempty"`
+	ExpiresAt         string            `json:"expires_at,omitempty"`
+	OAuthToken        string            `json:"oauth_token,omitempty"`
+	OAuthTokenSecret  string            `json:"oauth_token_secret,omitempty"`
+	APIKey            string            `json:"api_key,omitempty"`
+	Username          string            `json:"username,omitempty"`
+	Password          string            `json:"password,omitempty"`
+	AppID             string            `json:"app_id,omitempty"`
+	InstallationID    string            `json:"installation_id,omitempty"`
+}
+
+// PatchConnectionRequest is the body of PATCH /connections/{id}.
+type PatchConnectionRequest struct {
+	EndUser *EndUser `json:"end_user,omitempty"`
+}
+
+// GetConnectionOptions controls how GET /connection/{id} treats the
+// connection's credentials.
+type GetConnectionOptions struct {
+	// ForceRefresh refreshes OAuth credentials even if they have not expired.
+	ForceRefresh bool
+	// RefreshToken includes the refresh token in the returned credentials.
+	RefreshToken bool
+}
+
+type connectionListResponse struct {
+	Connections []ConnectionSummary `json:"connections"`
+}
+
+// ListConnections returns the connections in the environment that match
+// opts, requesting pages until an empty one is returned. A page that starts
+// with the same connection as the previous one also ends the listing, in
+// case the server ignores the page parameter.
+func (c *Client) ListConnections(ctx context.Context, opts ListConnectionsOptions) ([]ConnectionSummary, error) {
+	query := url.Values{}
+	if opts.Search != "" {
+		query.Set("search", opts.Search)
+	}
+	if opts.IntegrationID != "" {
+		query.Set("integrationId", opts.IntegrationID)
+	}
+	if opts.EndUserID != "" {
+		query.Set("endUserId", opts.EndUserID)
+	}
+	if opts.EndUserOrganizationID != "" {
+		query.Set("endUserOrganizationId", opts.EndUserOrganizationID)
+	}
+	query.Set("limit", strconv.Itoa(connectionsPageSize))
+
+	connections := []ConnectionSummary{}
+	var previousFirst int64
+	for page := 0; ; page++ {
+		query.Set("page", strconv.Itoa(page))
+
+		var out connectionListResponse
+		if err := c.do(ctx, http.MethodGet, "/connections", query, nil, &out); err != nil {
+			return nil, err
+		}
+		if len(out.Connections) == 0 || (page > 0 && out.Connections[0].ID == previousFirst) {
+			return connections, nil
+		}
+		previousFirst = out.Connections[0].ID
+		connections = append(connections, out.Connections...)
+	}
+}
+
+// GetConnection returns a single connection, including its credentials.
+// Nango refreshes expired OAuth credentials before returning them.
+func (c *Client) GetConnection(ctx context.Context, connectionID, providerConfigKey string, opts GetConnectionOptions) (*Connection, error) {
+	query := url.Values{}
+	query.Set("provider_config_key", providerConfigKey)
+	query.Set("force_refresh", strconv.FormatBool(opts.ForceRefresh))
+	query.Set("refresh_token", strconv.FormatBool(opts.RefreshToken))
+
+	var out Connection
+	if err := c.do(ctx, http.MethodGet, "/connection/"+url.PathEscape(connectionID), query, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ImportConnection creates a connection from existing credentials, or
+// replaces the credentials of an existing one.
+func (c *Client) ImportConnection(ctx context.Context, req ImportConnectionRequest) error {
+	return c.do(ctx, http.MethodPost, "/connection", nil, req, nil)
+}
+
+// PatchConnection updates the end user a connection belongs to.
+func (c *Client) PatchConnection(ctx context.Context, connectionID, providerConfigKey string, req PatchConnectionRequest) error {
+	query := url.Values{}
+	query.Set("provider_config_key", providerConfigKey)
+	return c.do(ctx, http.MethodPatch, "/connections/"+url.PathEscape(connectionID), query, req, nil)
+}
+
+// DeleteConnection deletes a connection.
+func (c *Client) DeleteConnection(ctx context.Context, connectionID, providerConfigKey string) error {
+	query := url.Values{}
+	query.Set("provider_config_key", providerConfigKey)
+	return c.do(ctx, http.MethodDelete, "/connection/"+url.PathEscape(connectionID), query, nil, nil)
+}
+
+// ConnectionMetadataRequest is the body of POST and PATCH
+// /connection/metadata.
+type ConnectionMetadataRequest struct {
+	ConnectionID      string         `json:"connection_id"`
+	ProviderConfigKey string         `json:"provider_config_key"`
+	Metadata          map[string]any `json:"metadata"`
+}
+
+// SetConnectionMetadata replaces all metadata of a connection.
+func (c *Client) SetConnectionMetadata(ctx context.Context, req ConnectionMetadataRequest) error {
+	return c.do(ctx, http.MethodPost, "/connection/metadata", nil, req, nil)
+}
+
+// UpdateConnectionMetadata merges the given keys into a connection's
+// metadata, leaving other keys untouched.
+func (c *Client) UpdateConnectionMetadata(ctx context.Context, req ConnectionMetadataRequest) error {
+	return c.do(ctx, http.MethodPatch, "/connection/metadata", nil, req, nil)
+}
@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package nango
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+// connectionPages serves connections with IDs 1 to total, capping the
+// requested limit at maxLimit. When ignorePage is set every request returns
+// the first page.
+func connectionPages(t *testing.T, total, maxLimit int, ignorePage bool, requests *int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+		if r.URL.Path != "/connections" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("integrationId"); got != "github" {
+			t.Errorf("integrationId = %q, want github", got)
+		}
+
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		limit = min(limit, maxLimit)
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		if ignorePage {
+			page = 0
+		}
+
+		out := connectionListResponse{Connections: []ConnectionSummary{}}
+		for id := page*limit + 1; id <= min((page+1)*limit, total); id++ {
+			out.Connections = append(out.Connections, ConnectionSummary{ID: int64(id), ConnectionID: strconv.Itoa(id)})
+		}
+		_ = json.NewEncoder(w).Encode(out)
+	}
+}
+
+func TestListConnectionsPagination(t *testing.T) {
+	tests := map[string]struct {
+		total        int
+		maxLimit     int
+		ignorePage   bool
+		want         int
+		wantRequests int
+	}{
+		"no connections":         {total: 0, maxLimit: connectionsPageSize, want: 0, wantRequests: 1},
+		"single page":            {total: 3, maxLimit: connectionsPageSize, want: 3, wantRequests: 2},
+		"limit capped by server": {total: 250, maxLimit: 100, want: 250, wantRequests: 4},
+		"exact multiple":         {total: 200, maxLimit: 100, want: 200, wantRequests: 3},
+		"page ignored":           {total: 250, maxLimit: 100, ignorePage: true, want: 100, wantRequests: 2},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var requests int
+			client := newTestClient(t, connectionPages(t, tt.total, tt.maxLimit, tt.ignorePage, &requests))
+
+			connections, err := client.ListConnections(context.Background(), ListConnectionsOptions{IntegrationID: "github"})
+			if err != nil {
+				t.Fatalf("ListConnections: %v", err)
+			}
+			if len(connections) != tt.want {
+				t.Errorf("got %d connections, want %d", len(connections), tt.want)
+			}
+			for i, connection := range connections {
+				if connection.ID != int64(i+1) {
+					t.Fatalf("connection %d has ID %d, want %d", i, connection.ID, i+1)
+				}
+			}
+			if requests != tt.wantRequests {
+				t.Errorf("made %d requests, want %d", requests, tt.wantRequests)
+			}
+		})
+	}
+}
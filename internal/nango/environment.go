@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package nango
+
+import (
+	"context"
+	"net/http"
+)
+
+// Environment holds the settings of the environment the client's key
+// belongs to.
+type Environment struct {
+	Name                string `json:"name"`
+	CallbackURL         string `json:"callback_url"`
+	WebhookURL          string `json:"webhook_url"`
+	WebhookURLSecondary string `json:"webhook_url_secondary"`
+	SendAuthWebhook     bool   `json:"send_auth_webhook"`
+	AlwaysSendWebhook   bool   `json:"always_send_webhook"`
+	HMACEnabled         bool   `json:"hmac_enabled"`
+	HMACKey             string `json:"hmac_key"`
+}
+
+// PatchEnvironmentRequest is the body of PATCH /environment. Nil fields are
+// left unchanged; an empty string clears a URL.
+type PatchEnvironmentRequest struct {
+	CallbackURL         *string `json:"callback_url,omitempty"`
+	WebhookURL          *string `json:"webhook_url,omitempty"`
+	WebhookURLSecondary *string `json:"webhook_url_secondary,omitempty"`
+	SendAuthWebhook     *bool   `json:"send_auth_webhook,omitempty"`
+	AlwaysSendWebhook   *bool   `json:"always_send_webhook,omitempty"`
+	HMACEnabled         *bool   `json:"hmac_enabled,omitempty"`
+	HMACKey             *string `json:"hmac_key,omitempty"`
+}
+
+type environmentResponse struct {
+	Data Environment `json:"data"`
+}
+
+// GetEnvironment returns the settings of the current environment.
+func (c *Client) GetEnvironment(ctx context.Context) (*Environment, error) {
+	var out environmentResponse
+	if err := c.do(ctx, http.MethodGet, "/environment", nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out.Data, nil
+}
+
+// PatchEnvironment updates the settings of the current environment and
+// returns the result.
+func (c *Client) PatchEnvironment(ctx context.Context, req PatchEnvironmentRequest) (*Environment, error) {
+	var out environmentResponse
+	if err := c.do(ctx, http.MethodPatch, "/environment", nil, req, &out); err != nil {
+		return nil, err
+	}
+	return &out.Data, nil
+}
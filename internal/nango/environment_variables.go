@@ -0,0 +1,36 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package nango
+
+import (
+	"context"
+	"net/http"
+)
+
+// EnvironmentVariable is a variable scripts of the environment can read.
+type EnvironmentVariable struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type setEnvironmentVariablesRequest struct {
+	Variables []EnvironmentVariable `json:"variables"`
+}
+
+// ListEnvironmentVariables returns the variables of the current environment.
+func (c *Client) ListEnvironmentVariables(ctx context.Context) ([]EnvironmentVariable, error) {
+	var out []EnvironmentVariable
+	if err := c.do(ctx, http.MethodGet, "/environment-variables", nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SetEnvironmentVariables replaces all variables of the current environment.
+func (c *Client) SetEnvironmentVariables(ctx context.Context, variables []EnvironmentVariable) error {
+	if variables == nil {
+		variables = []EnvironmentVariable{}
+	}
+	return c.do(ctx, http.MethodPost, "/environment-variables", nil, setEnvironmentVariablesRequest{Variables: variables}, nil)
+}
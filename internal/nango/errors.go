@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package nango
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// APIError is returned for any non-2xx response from the Nango API. When the
+// body carries Nango's error envelope its code, message, payload and
+// field-level errors are decoded; Body always keeps the raw response.
+type APIError struct {
+	Method     string
+	Path       string
+	StatusCode int
+	Code       string
+	Message    string
+	Payload    json.RawMessage
+	Errors     []FieldError
+	Body       string
+}
+
+// FieldError is a validation error reported against one field of the
+// request body. Path holds the field name and any nested keys, for example
+// ["credentials", "scopes"].
+type FieldError struct {
+	Code    string
+	Message string
+	Path    []string
+}
+
+// errorEnvelope covers both shapes Nango uses for errors:
+//
+//	{"error": {"code": "...", "message": "...", "payload": {...}, "errors": [...]}}
+//	{"error": "message", "type": "code", "payload": {...}}
+type errorEnvelope struct {
+	Error   json.RawMessage `json:"error"`
+	Type    string          `json:"type"`
+	Message string          `json:"message"`
+	Payload json.RawMessage `json:"payload"`
+}
+
+type errorObject struct {
+	Code    string          `json:"code"`
+	Message string          `json:"message"`
+	Payload json.RawMessage `json:"payload"`
+	Errors  []struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+		Path    []any  `json:"path"`
+	} `json:"errors"`
+}
+
+func newAPIError(method, path string, statusCode int, body []byte) *APIError {
+	apiErr := &APIError{
+		Method:     method,
+		Path:       path,
+		StatusCode: statusCode,
+		Body:       string(body),
+	}
+
+	var envelope errorEnvelope
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return apiErr
+	}
+	apiErr.Code = envelope.Type
+	apiErr.Message = envelope.Message
+	apiErr.Payload = envelope.Payload
+
+	var message string
+	var object errorObject
+	switch {
+	case len(envelope.Error) == 0:
+	case json.Unmarshal(envelope.Error, &message) == nil:
+		apiErr.Message = message
+	case json.Unmarshal(envelope.Error, &object) == nil:
+		if object.Code != "" {
+			apiErr.Code = object.Code
+		}
+		if object.Message != "" {
+			apiErr.Message = object.Message
+		}
+		if len(object.Payload) > 0 {
+			apiErr.Payload = object.Payload
+		}
+		for _, e := range object.Errors {
+			fieldErr := FieldError{Code: e.Code, Message: e.Message}
+			for _, p := range e.Path {
+				fieldErr.Path = append(fieldErr.Path, fmt.Sprint(p))
+			}
+			apiErr.Errors = append(apiErr.Errors, fieldErr)
+		}
+	}
+	return apiErr
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("%s %s returned HTTP %d: %s", e.Method, e.Path, e.StatusCode, e.Detail())
+}
+
+// Detail returns a human readable description of the error, preferring the
+// decoded envelope over the raw body.
+func (e *APIError) Detail() string {
+	var parts []string
+	if e.Message != "" {
+		parts = append(parts, e.Message)
+	}
+	for _, fieldErr := range e.Errors {
+		parts = append(parts, fieldErr.String())
+	}
+	if len(parts) == 0 {
+		if e.Code != "" {
+			return e.Code
+		}
+		if body := strings.TrimSpace(e.Body); body != "" {
+			return body
+		}
+		return http.StatusText(e.StatusCode)
+	}
+	detail := strings.Join(parts, "; ")
+	if e.Code != "" {
+		detail = fmt.Sprintf("%s (%s)", detail, e.Code)
+	}
+	return detail
+}
+
+func (e FieldError) String() string {
+	message := e.Message
+	if message == "" {
+		message = e.Code
+	}
+	if len(e.Path) == 0 {
+		return message
+	}
+	return fmt.Sprintf("%s: %s", strings.Join(e.Path, "."), message)
+}
+
+// IsNotFound reports whether err is an APIError for a 404 response.
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package nango
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestNewAPIError(t *testing.T) {
+	tests := map[string]struct {
+		body       string
+		wantCode   string
+		wantMsg    string
+		wantErrors []FieldError
+		wantDetail string
+	}{
+		"object envelope": {
+			body:     `{"error": {"code": "invalid_body", "message": "Invalid request", "errors": [{"code": "too_small", "message": "Required", "path": ["credentials", "scopes", 0]}]}}`,
+			wantCode: "invalid_body",
+			wantMsg:  "Invalid request",
+			wantErrors: []FieldError{
+				{Code: "too_small", Message: "Required", Path: []string{"credentials", "scopes", "0"}},
+			},
+			wantDetail: "Invalid request; credentials.scopes.0: Required (invalid_body)",
+		},
+		"string envelope": {
+			body:       `{"error": "Integration already exists", "type": "duplicate_key", "payload": {"key": "github"}}`,
+			wantCode:   "duplicate_key",
+			wantMsg:    "Integration already exists",
+			wantDetail: "Integration already exists (duplicate_key)",
+		},
+		"code only": {
+			body:       `{"error": {"code": "not_found"}}`,
+			wantCode:   "not_found",
+			wantDetail: "not_found",
+		},
+		"not json": {
+			body:       "upstream timeout",
+			wantDetail: "upstream timeout",
+		},
+		"empty body": {
+			wantDetail: http.StatusText(http.StatusBadGateway),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			apiErr := newAPIError(http.MethodPost, "/integrations", http.StatusBadGateway, []byte(tt.body))
+
+			if apiErr.Code != tt.wantCode {
+				t.Errorf("Code = %q, want %q", apiErr.Code, tt.wantCode)
+			}
+			if apiErr.Message != tt.wantMsg {
+				t.Errorf("Message = %q, want %q", apiErr.Message, tt.wantMsg)
+			}
+			if !reflect.DeepEqual(apiErr.Errors, tt.wantErrors) {
+				t.Errorf("Errors = %+v, want %+v", apiErr.Errors, tt.wantErrors)
+			}
+			if got := apiErr.Detail(); got != tt.wantDetail {
+				t.Errorf("Detail() = %q, want %q", got, tt.wantDetail)
+			}
+			if apiErr.Body != tt.body {
+				t.Errorf("Body = %q, want the raw body", apiErr.Body)
+			}
+		})
+	}
+}
+
+func TestNewAPIErrorPayload(t *testing.T) {
+	apiErr := newAPIError(http.MethodPost, "/integrations", http.StatusConflict, []byte(`{"error": "exists", "payload": {"key": "github"}}`))
+	if string(apiErr.Payload) != `{"key": "github"}` {
+		t.Errorf("Payload = %s, want the envelope payload", apiErr.Payload)
+	}
+}
+
+func TestIsNotFound(t *testing.T) {
+	tests := map[string]struct {
+		err  error
+		want bool
+	}{
+		"404":          {err: &APIError{StatusCode: http.StatusNotFound}, want: true},
+		"wrapped 404":  {err: fmt.Errorf("reading: %w", &APIError{StatusCode: http.StatusNotFound}), want: true},
+		"other status": {err: &APIError{StatusCode: http.StatusBadRequest}},
+		"other error":  {err: errors.New("connection refused")},
+		"nil":          {},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := IsNotFound(tt.err); got != tt.want {
+				t.Errorf("IsNotFound() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package nango
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// Include flags accepted by GetIntegration.
+const (
+	IncludeWebhook     = "webhook"
+	IncludeCredentials = "credentials"
+)
+
+// Integration is an integration as returned by the Nango API.
+type Integration struct {
+	UniqueKey       string                  `json:"unique_key"`
+	DisplayName     string                  `json:"display_name"`
+	Provider        string                  `json:"provider"`
+	Logo            string                  `json:"logo,omitempty"`
+	WebhookURL      string                  `json:"webhook_url,omitempty"`
+	ForwardWebhooks *bool                   `json:"forward_webhooks,omitempty"`
+	CreatedAt       string                  `json:"created_at,omitempty"`
+	UpdatedAt       string                  `json:"updated_at"`
+	Credentials     *IntegrationCredentials `json:"credentials,omitempty"`
+}
+
+// Credential types an integration can be configured with.
+const (
+	CredentialsOAuth2 = "OAUTH2"
+	CredentialsOAuth1 = "OAUTH1"
+	CredentialsTBA    = "TBA"
+	CredentialsApp    = "APP"
+	CredentialsCustom = "CUSTOM"
+)
+
+// IntegrationCredentials holds the credentials returned when an integration
+// is fetched with IncludeCredentials. Which fields are set depends on Type.
+type IntegrationCredentials struct {
+	Type          string `json:"type"`
+	ClientID      string `json:"client_id,omitempty"`
+	ClientSecret  string `json:"client_secret,omitempty"`
+	Scopes        string `json:"scopes,omitempty"`
+	AppID         string `json:"app_id,omitempty"`
+	AppLink       string `json:"app_link,omitempty"`
+	PrivateKey    string `json:"private_key,omitempty"`
+	WebhookSecret string `json:"webhook_secret,omitempty"`
+}
+
+// IntegrationCredentialsRequest is the credentials payload sent when
+// creating or updating an integration. Only the fields used by Type should
+// be set.
+type IntegrationCredentialsRequest struct {
+	Type         string  `json:"type"`
+	ClientID     string  `json:"client_id,omitempty"`
+	ClientSecret string  `json:"client_secret,omitempty"`
+	Scopes       *string `json:"scopes,omitempty"`
+	AppID        string  `json:"app_id,omitempty"`
+	AppLink      string  `json:"app_link,omitempty"`
+	PrivateKey   string  `json:"private_key,omitempty"`
+}
+
+// CreateIntegrationRequest is the body of POST /integrations.
+type CreateIntegrationRequest struct {
+	UniqueKey       string                         `json:"unique_key"`
+	Provider        string                         `json:"provider"`
+	DisplayName     string                         `json:"display_name,omitempty"`
+	Credentials     *IntegrationCredentialsRequest `json:"credentials,omitempty"`
+	ForwardWebhooks *bool                          `json:"forward_webhooks,omitempty"`
+}
+
+// PatchIntegrationRequest is the body of PATCH /integrations/{unique_key}.
+// Setting UniqueKey renames the integration.
+type PatchIntegrationRequest struct {
+	UniqueKey       *string                        `json:"unique_key,omitempty"`
+	DisplayName     *string                        `json:"display_name,omitempty"`
+	Credentials     *IntegrationCredentialsRequest `json:"credentials,omitempty"`
+	ForwardWebhooks *bool                          `json:"forward_webhooks,omitempty"`
+}
+
+type integrationResponse struct {
+	Data Integration `json:"data"`
+}
+
+type integrationListResponse struct {
+	Data []Integration `json:"data"`
+}
+
+// ListIntegrations returns every integration in the environment.
+func (c *Client) ListIntegrations(ctx context.Context) ([]Integration, error) {
+	var out integrationListResponse
+	if err := c.do(ctx, http.MethodGet, "/integrations", nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return out.Data, nil
+}
+
+// GetIntegration returns a single integration. The include flags ask the
+// API to add optional sections such as IncludeCredentials.
+func (c *Client) GetIntegration(ctx context.Context, uniqueKey string, include ...string) (*Integration, error) {
+	query := url.Values{}
+	for _, i := range include {
+		query.Add("include", i)
+	}
+
+	var out integrationResponse
+	if err := c.do(ctx, http.MethodGet, "/integrations/"+url.PathEscape(uniqueKey), query, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out.Data, nil
+}
+
+// CreateIntegration creates a new integration.
+func (c *Client) CreateIntegration(ctx context.Context, req CreateIntegrationRequest) (*Integration, error) {
+	var out integrationResponse
+	if err := c.do(ctx, http.MethodPost, "/integrations", nil, req, &out); err != nil {
+		return nil, err
+	}
+	return &out.Data, nil
+}
+
+// PatchIntegration updates an existing integration.
+func (c *Client) PatchIntegration(ctx context.Context, uniqueKey string, req PatchIntegrationRequest) (*Integration, error) {
+	var out integrationResponse
+	if err := c.do(ctx, http.MethodPatch, "/integrations/"+url.PathEscape(uniqueKey), nil, req, &out); err != nil {
+		return nil, err
+	}
+	return &out.Data, nil
+}
+
+// DeleteIntegration deletes an integration.
+func (c *Client) DeleteIntegration(ctx context.Context, uniqueKey string) error {
+	return c.do(ctx, http.MethodDelete, "/integrations/"+url.PathEscape(uniqueKey), nil, nil, nil)
+}
@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package nango
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// Provider is an entry of the Nango provider catalog.
+type Provider struct {
+	Name             string                           `json:"name"`
+	DisplayName      string                           `json:"display_name"`
+	LogoURL          string                           `json:"logo_url"`
+	AuthMode         string                           `json:"auth_mode"`
+	Categories       []string                         `json:"categories"`
+	Docs             string                           `json:"docs"`
+	DefaultScopes    []string                         `json:"default_scopes"`
+	ScopeSeparator   string                           `json:"scope_separator"`
+	ConnectionConfig map[string]ConnectionConfigField `json:"connection_config"`
+}
+
+// ConnectionConfigField describes a connection_config value a provider
+// needs, for example a subdomain.
+type ConnectionConfigField struct {
+	Type        string `json:"type"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Example     string `json:"example"`
+	Pattern     string `json:"pattern"`
+	Optional    bool   `json:"optional"`
+}
+
+type providerResponse struct {
+	Data Provider `json:"data"`
+}
+
+type providerListResponse struct {
+	Data []Provider `json:"data"`
+}
+
+// ListProviders returns the whole provider catalog.
+func (c *Client) ListProviders(ctx context.Context) ([]Provider, error) {
+	var out providerListResponse
+	if err := c.do(ctx, http.MethodGet, "/providers", nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return out.Data, nil
+}
+
+// GetProvider returns a single provider from the catalog. Providers are
+// cached for the lifetime of the client, so repeated lookups, such as one
+// per integration on refresh, only reach Nango once.
+func (c *Client) GetProvider(ctx context.Context, name string) (*Provider, error) {
+	c.providersMu.Lock()
+	provider, ok := c.providers[name]
+	c.providersMu.Unlock()
+	if ok {
+		return &provider, nil
+	}
+
+	var out providerResponse
+	if err := c.do(ctx, http.MethodGet, "/providers/"+url.PathEscape(name), nil, nil, &out); err != nil {
+		return nil, err
+	}
+
+	c.providersMu.Lock()
+	if c.providers == nil {
+		c.providers = map[string]Provider{}
+	}
+	c.providers[name] = out.Data
+	c.providersMu.Unlock()
+
+	return &out.Data, nil
+}
@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package nango
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"testing"
+)
+
+func TestGetProviderIsCached(t *testing.T) {
+	var calls atomic.Int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		switch r.URL.Path {
+		case "/providers/google":
+			_, _ = io.WriteString(w, `{"data": {"name": "google", "auth_mode": "OAUTH2", "scope_separator": " "}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	for range 3 {
+		provider, err := client.GetProvider(context.Background(), "google")
+		if err != nil {
+			t.Fatalf("GetProvider: %v", err)
+		}
+		if provider.ScopeSeparator != " " || provider.AuthMode != "OAUTH2" {
+			t.Errorf("unexpected provider %+v", provider)
+		}
+		// Callers cannot change the cached entry.
+		provider.ScopeSeparator = ","
+	}
+	if got := calls.Load(); got != 1 {
+		t.Errorf("server called %d times, want 1", got)
+	}
+
+	// Failed lookups are not cached.
+	for range 2 {
+		if _, err := client.GetProvider(context.Background(), "unknown"); !IsNotFound(err) {
+			t.Fatalf("GetProvider: err = %v, want not found", err)
+		}
+	}
+	if got := calls.Load(); got != 3 {
+		t.Errorf("server called %d times, want 3", got)
+	}
+}
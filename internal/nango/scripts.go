@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package nango
+
+import (
+	"context"
+	"net/http"
+)
+
+// Script types.
+const (
+	ScriptTypeSync   = "sync"
+	ScriptTypeAction = "action"
+)
+
+// IntegrationScripts lists the syncs and actions available to one
+// integration, as returned by GET /scripts/config.
+type IntegrationScripts struct {
+	ProviderConfigKey string   `json:"providerConfigKey"`
+	Provider          string   `json:"provider"`
+	Syncs             []Script `json:"syncs"`
+	Actions           []Script `json:"actions"`
+}
+
+// Script is a sync or action, either a template or a custom script.
+type Script struct {
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	Enabled bool   `json:"enabled"`
+}
+
+// ScriptToggle enables or disables one script.
+type ScriptToggle struct {
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	Enabled bool   `json:"enabled"`
+}
+
+// UpdateScriptsRequest is the body of PATCH /scripts/config.
+type UpdateScriptsRequest struct {
+	ProviderConfigKey string         `json:"provider_config_key"`
+	Scripts           []ScriptToggle `json:"scripts"`
+}
+
+// ListScripts returns the scripts available to each integration of the
+// environment, with whether they are enabled.
+func (c *Client) ListScripts(ctx context.Context) ([]IntegrationScripts, error) {
+	var out []IntegrationScripts
+	if err := c.do(ctx, http.MethodGet, "/scripts/config", nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetScripts returns the scripts available to one integration, or nil when
+// the environment has no such integration.
+func (c *Client) GetScripts(ctx context.Context, providerConfigKey string) (*IntegrationScripts, error) {
+	integrations, err := c.ListScripts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range integrations {
+		if integrations[i].ProviderConfigKey == providerConfigKey {
+			return &integrations[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// UpdateScripts enables or disables scripts of an integration.
+func (c *Client) UpdateScripts(ctx context.Context, req UpdateScriptsRequest) error {
+	return c.do(ctx, http.MethodPatch, "/scripts/config", nil, req, nil)
+}
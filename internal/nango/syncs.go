@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package nango
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Sync statuses reported by GET /sync/status.
+const (
+	SyncStatusRunning = "RUNNING"
+	SyncStatusSuccess = "SUCCESS"
+	SyncStatusError   = "ERROR"
+	SyncStatusPaused  = "PAUSED"
+	SyncStatusStopped = "STOPPED"
+)
+
+// SyncsRequest is the body of POST /sync/start, /sync/pause and
+// /sync/trigger. An empty ConnectionID applies to every connection of the
+// integration.
+type SyncsRequest struct {
+	ProviderConfigKey string   `json:"provider_config_key"`
+	Syncs             []string `json:"syncs"`
+	ConnectionID      string   `json:"connection_id,omitempty"`
+	// FullResync discards the sync's checkpoint. Only used by /sync/trigger.
+	FullResync bool `json:"full_resync,omitempty"`
+}
+
+// UpdateSyncFrequencyRequest is the body of PUT
+// /sync/update-connection-frequency. A nil Frequency resets the sync to
+// the frequency of its script.
+type UpdateSyncFrequencyRequest struct {
+	SyncName          string  `json:"sync_name"`
+	ProviderConfigKey string  `json:"provider_config_key"`
+	ConnectionID      string  `json:"connection_id"`
+	Frequency         *string `json:"frequency"`
+}
+
+// SyncStatus is the status of one sync of one connection.
+type SyncStatus struct {
+	ID                  string                     `json:"id"`
+	ConnectionID        string                     `json:"connection_id"`
+	Name                string                     `json:"name"`
+	Status              string                     `json:"status"`
+	Type                string                     `json:"type"`
+	Frequency           string                     `json:"frequency"`
+	FinishedAt          string                     `json:"finishedAt"`
+	NextScheduledSyncAt string                     `json:"nextScheduledSyncAt"`
+	LatestResult        map[string]SyncModelResult `json:"latestResult"`
+	RecordCount         map[string]int64           `json:"recordCount"`
+}
+
+// SyncModelResult counts the records a sync run changed for one model.
+type SyncModelResult struct {
+	Added   int64 `json:"added"`
+	Updated int64 `json:"updated"`
+	Deleted int64 `json:"deleted"`
+}
+
+type syncStatusResponse struct {
+	Syncs []SyncStatus `json:"syncs"`
+}
+
+type syncFrequencyResponse struct {
+	Frequency string `json:"frequency"`
+}
+
+// StartSyncs starts, or resumes, the given syncs.
+func (c *Client) StartSyncs(ctx context.Context, req SyncsRequest) error {
+	return c.do(ctx, http.MethodPost, "/sync/start", nil, req, nil)
+}
+
+// PauseSyncs pauses the given syncs.
+func (c *Client) PauseSyncs(ctx context.Context, req SyncsRequest) error {
+	return c.do(ctx, http.MethodPost, "/sync/pause", nil, req, nil)
+}
+
+// TriggerSyncs runs the given syncs once, outside of their schedule.
+func (c *Client) TriggerSyncs(ctx context.Context, req SyncsRequest) error {
+	return c.do(ctx, http.MethodPost, "/sync/trigger", nil, req, nil)
+}
+
+// UpdateSyncFrequency overrides the frequency of a sync for one connection
+// and returns the frequency now in effect.
+func (c *Client) UpdateSyncFrequency(ctx context.Context, req UpdateSyncFrequencyRequest) (string, error) {
+	var out syncFrequencyResponse
+	if err := c.do(ctx, http.MethodPut, "/sync/update-connection-frequency", nil, req, &out); err != nil {
+		return "", err
+	}
+	return out.Frequency, nil
+}
+
+// GetSyncStatus returns the status of the given syncs of an integration.
+// No syncs means all of them; an empty connectionID means all connections.
+func (c *Client) GetSyncStatus(ctx context.Context, providerConfigKey, connectionID string, syncs []string) ([]SyncStatus, error) {
+	query := url.Values{}
+	query.Set("provider_config_key", providerConfigKey)
+	if connectionID != "" {
+		query.Set("connection_id", connectionID)
+	}
+	if len(syncs) == 0 {
+		query.Set("syncs", "*")
+	} else {
+		query.Set("syncs", strings.Join(syncs, ","))
+	}
+
+	var out syncStatusResponse
+	if err := c.do(ctx, http.MethodGet, "/sync/status", query, nil, &out); err != nil {
+		return nil, err
+	}
+	return out.Syncs, nil
+}
@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-nango/internal/nango"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ ephemeral.EphemeralResource              = &connectSessionEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &connectSessionEphemeralResource{}
+)
+
+// NewConnectSessionEphemeralResource is a helper function to simplify the provider implementation.
+func NewConnectSessionEphemeralResource() ephemeral.EphemeralResource {
+	return &connectSessionEphemeralResource{}
+}
+
+// connectSessionModel maps the nango_connect_session ephemeral resource schema data.
+type connectSessionModel struct {
+	EndUser             *endUserModel      `tfsdk:"end_user"`
+	Organization        *organizationModel `tfsdk:"organization"`
+	AllowedIntegrations []types.String     `tfsdk:"allowed_integrations"`
+	Token               types.String       `tfsdk:"token"`
+	ExpiresAt           types.String       `tfsdk:"expires_at"`
+}
+
+// connectSessionEphemeralResource is the ephemeral resource implementation.
+type connectSessionEphemeralResource struct {
+	client *nango.Client
+}
+
+// Metadata returns the ephemeral resource type name.
+func (e *connectSessionEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connect_session"
+}
+
+// Schema defines the schema for the ephemeral resource.
+func (e *connectSessionEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Creates a short-lived Nango Connect session token for an end user. The token is only available during the current Terraform run and is never stored in state or plan.",
+		Attributes: map[string]schema.Attribute{
+			"end_user": schema.SingleNestedAttribute{
+				Required:            true,
+				MarkdownDescription: "The end user the session is for",
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "Your ID for the end user",
+					},
+					"email": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "The end user's email address",
+					},
+					"display_name": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "The end user's display name",
+					},
+				},
+			},
+			"organization": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "The organization of the end user",
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "Your ID for the organization",
+					},
+					"display_name": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "The organization's display name",
+					},
+				},
+			},
+			"allowed_integrations": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The `unique_key`s of the integrations the end user may connect. Defaults to all integrations.",
+			},
+			"token": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The Connect session token",
+			},
+			"expires_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "When the token expires",
+			},
+		},
+	}
+}
+
+// Open creates the Connect session.
+func (e *connectSessionEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data connectSessionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := nango.CreateConnectSessionRequest{
+		EndUser: nango.EndUser{
+			ID:          data.EndUser.Id.ValueString(),
+			Email:       data.EndUser.Email.ValueString(),
+			DisplayName: data.EndUser.DisplayName.ValueString(),
+		},
+	}
+	if data.Organization != nil {
+		request.Organization = &nango.Organization{
+			ID:          data.Organization.Id.ValueString(),
+			DisplayName: data.Organization.DisplayName.ValueString(),
+		}
+	}
+	for _, integration := range data.AllowedIntegrations {
+		request.AllowedIntegrations = append(request.AllowedIntegrations, integration.ValueString())
+	}
+
+	session, err := e.client.CreateConnectSession(ctx, request)
+	if err != nil {
+		addNangoError(&resp.Diagnostics, "Unable to Create Connect Session", err, nil)
+		return
+	}
+
+	data.Token = types.StringValue(session.Token)
+	data.ExpiresAt = types.StringValue(session.ExpiresAt)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// Configure adds the provider configured client to the ephemeral resource.
+func (e *connectSessionEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*nango.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *nango.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	e.client = client
+}
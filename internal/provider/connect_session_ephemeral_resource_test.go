@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-nango/internal/nango"
+)
+
+func TestConnectSessionOpen(t *testing.T) {
+	ctx := context.Background()
+
+	tests := map[string]struct {
+		config      connectSessionModel
+		status      int
+		wantRequest nango.CreateConnectSessionRequest
+		wantError   bool
+	}{
+		"end user only": {
+			config: connectSessionModel{
+				EndUser: &endUserModel{Id: types.StringValue("user-1"), Email: types.StringNull(), DisplayName: types.StringNull()},
+			},
+			wantRequest: nango.CreateConnectSessionRequest{EndUser: nango.EndUser{ID: "user-1"}},
+		},
+		"organization and allowed integrations": {
+			config: connectSessionModel{
+				EndUser:             &endUserModel{Id: types.StringValue("user-1"), Email: types.StringValue("user@example.com"), DisplayName: types.StringValue("User")},
+				Organization:        &organizationModel{Id: types.StringValue("org-1"), DisplayName: types.StringNull()},
+				AllowedIntegrations: []types.String{types.StringValue("github"), types.StringValue("slack")},
+			},
+			wantRequest: nango.CreateConnectSessionRequest{
+				EndUser:             nango.EndUser{ID: "user-1", Email: "user@example.com", DisplayName: "User"},
+				Organization:        &nango.Organization{ID: "org-1"},
+				AllowedIntegrations: []string{"github", "slack"},
+			},
+		},
+		"api error": {
+			config: connectSessionModel{
+				EndUser: &endUserModel{Id: types.StringValue("user-1"), Email: types.StringNull(), DisplayName: types.StringNull()},
+			},
+			status:      http.StatusBadRequest,
+			wantRequest: nango.CreateConnectSessionRequest{EndUser: nango.EndUser{ID: "user-1"}},
+			wantError:   true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var gotRequest nango.CreateConnectSessionRequest
+			e := &connectSessionEphemeralResource{client: testNangoClient(t, func(w http.ResponseWriter, req *http.Request) {
+				if req.Method != http.MethodPost || req.URL.Path != "/connect/sessions" {
+					t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+				}
+				if err := json.NewDecoder(req.Body).Decode(&gotRequest); err != nil {
+					t.Errorf("decoding request body: %v", err)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				if tt.status != 0 {
+					w.WriteHeader(tt.status)
+					_, _ = w.Write([]byte(`{"error":{"code":"invalid_body","message":"Invalid body"}}`))
+					return
+				}
+				_, _ = w.Write([]byte(`{"data":{"token":"nango_connect_session_abc","expires_at":"2024-01-01T00:30:00Z"}}`))
+			})}
+
+			var schemaResp ephemeral.SchemaResponse
+			e.Schema(ctx, ephemeral.SchemaRequest{}, &schemaResp)
+
+			// tfsdk.Config cannot be set directly, so build its value through
+			// a state of the same schema.
+			tt.config.Token = types.StringNull()
+			tt.config.ExpiresAt = types.StringNull()
+			configState := tfsdk.State{Schema: schemaResp.Schema}
+			if diags := configState.Set(ctx, &tt.config); diags.HasError() {
+				t.Fatalf("setting config: %v", diags)
+			}
+
+			req := ephemeral.OpenRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: configState.Raw}}
+			resp := ephemeral.OpenResponse{Result: tfsdk.EphemeralResultData{Schema: schemaResp.Schema}}
+			e.Open(ctx, req, &resp)
+
+			if !reflect.DeepEqual(gotRequest, tt.wantRequest) {
+				t.Errorf("request = %+v, want %+v", gotRequest, tt.wantRequest)
+			}
+			if resp.Diagnostics.HasError() != tt.wantError {
+				t.Fatalf("HasError = %t, want %t: %v", resp.Diagnostics.HasError(), tt.wantError, resp.Diagnostics)
+			}
+			if tt.wantError {
+				return
+			}
+
+			var result connectSessionModel
+			if diags := resp.Result.Get(ctx, &result); diags.HasError() {
+				t.Fatalf("reading result: %v", diags)
+			}
+			if got := result.Token.ValueString(); got != "nango_connect_session_abc" {
+				t.Errorf("token = %q, want nango_connect_session_abc", got)
+			}
+			if got := result.ExpiresAt.ValueString(); got != "2024-01-01T00:30:00Z" {
+				t.Errorf("expires_at = %q, want 2024-01-01T00:30:00Z", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,384 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-nango/internal/nango"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &connectionMetadataResource{}
+	_ resource.ResourceWithConfigure      = &connectionMetadataResource{}
+	_ resource.ResourceWithImportState    = &connectionMetadataResource{}
+	_ resource.ResourceWithValidateConfig = &connectionMetadataResource{}
+)
+
+// NewConnectionMetadataResource is a helper function to simplify the provider implementation.
+func NewConnectionMetadataResource() resource.Resource {
+	return &connectionMetadataResource{}
+}
+
+// connectionMetadataResourceModel maps the nango_connection_metadata resource schema data.
+type connectionMetadataResourceModel struct {
+	ConnectionId      types.String         `tfsdk:"connection_id"`
+	ProviderConfigKey types.String         `tfsdk:"provider_config_key"`
+	Metadata          jsontypes.Normalized `tfsdk:"metadata"`
+}
+
+// connectionMetadataResource is the resource implementation. It owns only
+// the top-level metadata keys in its configuration; keys written by others,
+// such as syncs or the application, are left alone.
+//
+// Nango cannot delete single metadata keys, so removing managed keys reads
+// the whole metadata and writes it back without them. A key that another
+// writer sets between the read and the write is lost; see Update and Delete.
+type connectionMetadataResource struct {
+	client *nango.Client
+}
+
+// Metadata returns the resource type name.
+func (r *connectionMetadataResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connection_metadata"
+}
+
+// Schema defines the schema for the resource.
+func (r *connectionMetadataResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages keys in the metadata of an existing Nango connection. Only the top-level keys set in `metadata` are managed; other keys are left untouched.\n\n" +
+			"Nango cannot delete single metadata keys, so removing a key from `metadata`, or destroying the resource, reads the connection's metadata and writes it back without the removed keys. " +
+			"Changes another writer makes to the metadata in between are overwritten.",
+		Attributes: map[string]schema.Attribute{
+			"connection_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The connection ID. Changing this forces a new resource to be created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"provider_config_key": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The `unique_key` of the connection's integration. Changing this forces a new resource to be created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"metadata": schema.StringAttribute{
+				CustomType:          jsontypes.NormalizedType{},
+				Required:            true,
+				MarkdownDescription: "The metadata keys to manage, as a JSON object. Use `jsonencode` to build it.",
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *connectionMetadataResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan connectionMetadataResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	metadata, err := decodeMetadata(plan.Metadata.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("metadata"), "Invalid Metadata", err.Error())
+		return
+	}
+
+	err = r.client.UpdateConnectionMetadata(ctx, nango.ConnectionMetadataRequest{
+		ConnectionID:      plan.ConnectionId.ValueString(),
+		ProviderConfigKey: plan.ProviderConfigKey.ValueString(),
+		Metadata:          metadata,
+	})
+	if err != nil {
+		addNangoError(&resp.Diagnostics, "Unable to Set Connection Metadata", err, connectionMetadataAttributePath)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *connectionMetadataResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state connectionMetadataResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connection, err := r.client.GetConnection(ctx, state.ConnectionId.ValueString(), state.ProviderConfigKey.ValueString(), nango.GetConnectionOptions{})
+	if nango.IsNotFound(err) {
+		// The connection is gone and its metadata with it.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addNangoError(&resp.Diagnostics, "Error Reading Nango Connection "+state.ConnectionId.ValueString(), err, nil)
+		return
+	}
+
+	managed, err := decodeMetadata(state.Metadata.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("metadata"), "Invalid Metadata in State", err.Error())
+		return
+	}
+
+	metadata, changed := managedMetadata(managed, connection.Metadata)
+	if changed {
+		encoded, err := json.Marshal(metadata)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Encode Connection Metadata", err.Error())
+			return
+		}
+		state.Metadata = jsontypes.NewNormalizedValue(string(encoded))
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *connectionMetadataResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state connectionMetadataResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planned, err := decodeMetadata(plan.Metadata.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("metadata"), "Invalid Metadata", err.Error())
+		return
+	}
+	prior, err := decodeMetadata(state.Metadata.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("metadata"), "Invalid Metadata in State", err.Error())
+		return
+	}
+
+	var removed []string
+	for key := range prior {
+		if _, ok := planned[key]; !ok {
+			removed = append(removed, key)
+		}
+	}
+
+	request := nango.ConnectionMetadataRequest{
+		ConnectionID:      plan.ConnectionId.ValueString(),
+		ProviderConfigKey: plan.ProviderConfigKey.ValueString(),
+		Metadata:          planned,
+	}
+	if len(removed) == 0 {
+		err = r.client.UpdateConnectionMetadata(ctx, request)
+	} else {
+		// A merge cannot delete keys, so rewrite the whole metadata without
+		// the keys that are no longer managed. This read-modify-write is not
+		// atomic: keys another writer changes in between are overwritten.
+		request.Metadata, err = r.remoteMetadataWithout(ctx, plan, removed)
+		if err == nil {
+			for key, value := range planned {
+				request.Metadata[key] = value
+			}
+			err = r.client.SetConnectionMetadata(ctx, request)
+		}
+	}
+	if err != nil {
+		addNangoError(&resp.Diagnostics, "Unable to Update Connection Metadata", err, connectionMetadataAttributePath)
+		return
+	}
+
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes the managed keys from the connection's metadata.
+func (r *connectionMetadataResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state connectionMetadataResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	managed, err := decodeMetadata(state.Metadata.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("metadata"), "Invalid Metadata in State", err.Error())
+		return
+	}
+	keys := make([]string, 0, len(managed))
+	for key := range managed {
+		keys = append(keys, key)
+	}
+
+	// As in Update, the metadata is rewritten without the managed keys, which
+	// races with other writers of the connection's metadata.
+	metadata, err := r.remoteMetadataWithout(ctx, state, keys)
+	if err == nil {
+		err = r.client.SetConnectionMetadata(ctx, nango.ConnectionMetadataRequest{
+			ConnectionID:      state.ConnectionId.ValueString(),
+			ProviderConfigKey: state.ProviderConfigKey.ValueString(),
+			Metadata:          metadata,
+		})
+	}
+	// The connection is already gone, and its metadata with it.
+	if err != nil && !nango.IsNotFound(err) {
+		addNangoError(&resp.Diagnostics, "Unable to Delete Connection Metadata", err, nil)
+		return
+	}
+}
+
+// ValidateConfig checks that metadata is a JSON object.
+func (r *connectionMetadataResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var metadata jsontypes.Normalized
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("metadata"), &metadata)...)
+	if resp.Diagnostics.HasError() || metadata.IsNull() || metadata.IsUnknown() {
+		return
+	}
+
+	if _, err := decodeMetadata(metadata.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("metadata"), "Invalid Metadata", err.Error())
+	}
+}
+
+// remoteMetadataWithout returns the connection's current metadata with the
+// given keys removed.
+func (r *connectionMetadataResource) remoteMetadataWithout(ctx context.Context, model connectionMetadataResourceModel, keys []string) (map[string]any, error) {
+	connection, err := r.client.GetConnection(ctx, model.ConnectionId.ValueString(), model.ProviderConfigKey.ValueString(), nango.GetConnectionOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := map[string]any{}
+	for key, value := range connection.Metadata {
+		metadata[key] = value
+	}
+	for _, key := range keys {
+		delete(metadata, key)
+	}
+	return metadata, nil
+}
+
+// decodeMetadata parses a JSON object of metadata.
+func decodeMetadata(value string) (map[string]any, error) {
+	var metadata map[string]any
+	if err := json.Unmarshal([]byte(value), &metadata); err != nil {
+		return nil, fmt.Errorf("metadata must be a JSON object: %w", err)
+	}
+	if metadata == nil {
+		return nil, fmt.Errorf("metadata must be a JSON object, got null")
+	}
+	return metadata, nil
+}
+
+// managedMetadata returns the remote values of the managed keys and whether
+// any of them differ from the managed values. Keys missing remotely are
+// left out so the next plan adds them back.
+func managedMetadata(managed, remote map[string]any) (map[string]any, bool) {
+	metadata := map[string]any{}
+	changed := false
+	for key, value := range managed {
+		remoteValue, ok := remote[key]
+		if !ok {
+			changed = true
+			continue
+		}
+		if !reflect.DeepEqual(value, remoteValue) {
+			changed = true
+		}
+		metadata[key] = remoteValue
+	}
+	return metadata, changed
+}
+
+// connectionMetadataAttributePath maps fields of the metadata request body
+// to their schema attributes.
+func connectionMetadataAttributePath(field []string) (path.Path, bool) {
+	if len(field) == 0 {
+		return path.Empty(), false
+	}
+
+	switch field[0] {
+	case "connection_id", "provider_config_key", "metadata":
+		return path.Root(field[0]), true
+	}
+	return path.Empty(), false
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *connectionMetadataResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*nango.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *nango.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ImportState imports the resource into Terraform state. All metadata keys
+// the connection has at import time become managed.
+func (r *connectionMetadataResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// The import ID is <provider_config_key>/<connection_id>
+	providerConfigKey, connectionID, ok := strings.Cut(req.ID, "/")
+	if !ok || providerConfigKey == "" || connectionID == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			"Expected an import ID of the form <provider_config_key>/<connection_id>, got: "+req.ID,
+		)
+		return
+	}
+
+	connection, err := r.client.GetConnection(ctx, connectionID, providerConfigKey, nango.GetConnectionOptions{})
+	if err != nil {
+		addNangoError(&resp.Diagnostics, "Unable to Import Connection Metadata "+req.ID, err, nil)
+		return
+	}
+
+	metadata := connection.Metadata
+	if metadata == nil {
+		metadata = map[string]any{}
+	}
+	encoded, err := json.Marshal(metadata)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Encode Connection Metadata", err.Error())
+		return
+	}
+
+	state := connectionMetadataResourceModel{
+		ConnectionId:      types.StringValue(connection.ConnectionID),
+		ProviderConfigKey: types.StringValue(connection.ProviderConfigKey),
+		Metadata:          jsontypes.NewNormalizedValue(string(encoded)),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,181 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-nango/internal/nango"
+)
+
+func TestDecodeMetadata(t *testing.T) {
+	tests := map[string]struct {
+		value     string
+		want      map[string]any
+		wantError bool
+	}{
+		"object": {value: `{"team":"core","limits":{"rate":10}}`, want: map[string]any{"team": "core", "limits": map[string]any{"rate": float64(10)}}},
+		"empty":  {value: `{}`, want: map[string]any{}},
+		"null":   {value: `null`, wantError: true},
+		"array":  {value: `["team"]`, wantError: true},
+		"string": {value: `"team"`, wantError: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := decodeMetadata(tt.value)
+			if (err != nil) != tt.wantError {
+				t.Fatalf("decodeMetadata(%s) error = %v, want error %t", tt.value, err, tt.wantError)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("decodeMetadata(%s) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestManagedMetadata(t *testing.T) {
+	managed := map[string]any{"team": "core", "limits": map[string]any{"rate": float64(10)}}
+
+	tests := map[string]struct {
+		remote      map[string]any
+		want        map[string]any
+		wantChanged bool
+	}{
+		"unchanged": {
+			remote: map[string]any{"team": "core", "limits": map[string]any{"rate": float64(10)}},
+			want:   managed,
+		},
+		"unmanaged keys ignored": {
+			remote: map[string]any{"team": "core", "limits": map[string]any{"rate": float64(10)}, "cursor": "abc"},
+			want:   managed,
+		},
+		"changed value": {
+			remote:      map[string]any{"team": "growth", "limits": map[string]any{"rate": float64(10)}},
+			want:        map[string]any{"team": "growth", "limits": map[string]any{"rate": float64(10)}},
+			wantChanged: true,
+		},
+		"changed nested value": {
+			remote:      map[string]any{"team": "core", "limits": map[string]any{"rate": float64(20)}},
+			want:        map[string]any{"team": "core", "limits": map[string]any{"rate": float64(20)}},
+			wantChanged: true,
+		},
+		"missing key": {
+			remote:      map[string]any{"team": "core"},
+			want:        map[string]any{"team": "core"},
+			wantChanged: true,
+		},
+		"no remote metadata": {
+			want:        map[string]any{},
+			wantChanged: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, changed := managedMetadata(managed, tt.remote)
+			if changed != tt.wantChanged {
+				t.Errorf("changed = %t, want %t", changed, tt.wantChanged)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("metadata = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConnectionMetadataUpdate(t *testing.T) {
+	ctx := context.Background()
+	remote := map[string]any{"team": "core", "region": "eu", "cursor": "abc"}
+
+	tests := map[string]struct {
+		prior      string
+		plan       string
+		wantMethod string
+		want       map[string]any
+	}{
+		"changed value merges": {
+			prior:      `{"team":"core"}`,
+			plan:       `{"team":"growth"}`,
+			wantMethod: http.MethodPatch,
+			want:       map[string]any{"team": "growth"},
+		},
+		"added key merges": {
+			prior:      `{"team":"core"}`,
+			plan:       `{"team":"core","tier":"gold"}`,
+			wantMethod: http.MethodPatch,
+			want:       map[string]any{"team": "core", "tier": "gold"},
+		},
+		"removed key rewrites the rest": {
+			prior:      `{"team":"core","region":"eu"}`,
+			plan:       `{"team":"growth"}`,
+			wantMethod: http.MethodPost,
+			want:       map[string]any{"team": "growth", "cursor": "abc"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var gotMethod string
+			var got nango.ConnectionMetadataRequest
+			r := &connectionMetadataResource{client: testNangoClient(t, func(w http.ResponseWriter, req *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch {
+				case req.Method == http.MethodGet && req.URL.Path == "/connection/acme":
+					_ = json.NewEncoder(w).Encode(nango.Connection{ConnectionID: "acme", Metadata: remote})
+				case req.URL.Path == "/connection/metadata":
+					gotMethod = req.Method
+					if err := json.NewDecoder(req.Body).Decode(&got); err != nil {
+						t.Errorf("decoding request body: %v", err)
+					}
+					_, _ = w.Write([]byte(`{}`))
+				default:
+					t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			})}
+
+			var schemaResp resource.SchemaResponse
+			r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+			req := resource.UpdateRequest{
+				Plan:  tfsdk.Plan{Schema: schemaResp.Schema},
+				State: tfsdk.State{Schema: schemaResp.Schema},
+			}
+			model := func(metadata string) connectionMetadataResourceModel {
+				return connectionMetadataResourceModel{
+					ConnectionId:      types.StringValue("acme"),
+					ProviderConfigKey: types.StringValue("github"),
+					Metadata:          jsontypes.NewNormalizedValue(metadata),
+				}
+			}
+			if diags := req.State.Set(ctx, model(tt.prior)); diags.HasError() {
+				t.Fatalf("setting state: %v", diags)
+			}
+			if diags := req.Plan.Set(ctx, model(tt.plan)); diags.HasError() {
+				t.Fatalf("setting plan: %v", diags)
+			}
+
+			resp := resource.UpdateResponse{State: req.State}
+			r.Update(ctx, req, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if gotMethod != tt.wantMethod {
+				t.Errorf("metadata written with %s, want %s", gotMethod, tt.wantMethod)
+			}
+			if !reflect.DeepEqual(got.Metadata, tt.want) {
+				t.Errorf("metadata written = %v, want %v", got.Metadata, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,802 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-nango/internal/nango"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &connectionResource{}
+	_ resource.ResourceWithConfigure      = &connectionResource{}
+	_ resource.ResourceWithImportState    = &connectionResource{}
+	_ resource.ResourceWithModifyPlan     = &connectionResource{}
+	_ resource.ResourceWithValidateConfig = &connectionResource{}
+)
+
+// NewConnectionResource is a helper function to simplify the provider implementation.
+func NewConnectionResource() resource.Resource {
+	return &connectionResource{}
+}
+
+// connectionResourceModel maps the nango_connection resource schema data.
+type connectionResourceModel struct {
+	ConnectionId         types.String                `tfsdk:"connection_id"`
+	ProviderConfigKey    types.String                `tfsdk:"provider_config_key"`
+	Credentials          *connectionCredentialsModel `tfsdk:"credentials"`
+	ConnectionConfig     types.Map                   `tfsdk:"connection_config"`
+	EndUser              *endUserModel               `tfsdk:"end_user"`
+	Organization         *organizationModel          `tfsdk:"organization"`
+	NangoProvider        types.String                `tfsdk:"nango_provider"`
+	CreatedAt            types.String                `tfsdk:"created_at"`
+	LastFetchedAt        types.String                `tfsdk:"last_fetched_at"`
+	CredentialsExpiresAt types.String                `tfsdk:"credentials_expires_at"`
+	RefreshFailed        types.Bool                  `tfsdk:"refresh_failed"`
+	Errors               types.List                  `tfsdk:"errors"`
+}
+
+type connectionCredentialsModel struct {
+	Type             types.String `tfsdk:"type"`
+	AccessToken      types.String `tfsdk:"access_token"`
+	RefreshToken     types.String `tfsdk:"refresh_token"`
+	ExpiresAt        types.String `tfsdk:"expires_at"`
+	OAuthToken       types.String `tfsdk:"oauth_token"`
+	OAuthTokenSecret types.String `tfsdk:"oauth_token_secret"`
+	ApiKey           types.String `tfsdk:"api_key"`
+	Username         types.String `tfsdk:"username"`
+	Password         types.String `tfsdk:"password"`
+	AppId            types.String `tfsdk:"app_id"`
+	InstallationId   types.String `tfsdk:"installation_id"`
+}
+
+type endUserModel struct {
+	Id          types.String `tfsdk:"id"`
+	Email       types.String `tfsdk:"email"`
+	DisplayName types.String `tfsdk:"display_name"`
+}
+
+type organizationModel struct {
+	Id          types.String `tfsdk:"id"`
+	DisplayName types.String `tfsdk:"display_name"`
+}
+
+type connectionErrorModel struct {
+	Type  types.String `tfsdk:"type"`
+	LogId types.String `tfsdk:"log_id"`
+}
+
+var connectionErrorType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"type":   types.StringType,
+		"log_id": types.StringType,
+	},
+}
+
+// connectionCredentialFields lists the credential attributes each
+// credential type requires.
+var connectionCredentialFields = map[string][]string{
+	"OAUTH2":  {"access_token"},
+	"OAUTH1":  {"oauth_token", "oauth_token_secret"},
+	"API_KEY": {"api_key"},
+	"BASIC":   {"username"},
+	"APP":     {"app_id", "installation_id"},
+}
+
+// connectionResource is the resource implementation.
+type connectionResource struct {
+	client *nango.Client
+}
+
+// Metadata returns the resource type name.
+func (r *connectionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connection"
+}
+
+// Schema defines the schema for the resource.
+func (r *connectionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Nango connection created from existing credentials, such as an API key, basic auth or imported OAuth tokens.",
+		Attributes: map[string]schema.Attribute{
+			"connection_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The connection ID. Changing this forces a new connection to be created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"provider_config_key": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The `unique_key` of the integration the connection belongs to. Changing this forces a new connection to be created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"credentials": schema.SingleNestedAttribute{
+				Required:            true,
+				MarkdownDescription: "The credentials of the connection. Which attributes are required depends on `type`. Changing them replaces the credentials held by Nango.",
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "The type of credential: `OAUTH2`, `OAUTH1`, `API_KEY`, `BASIC` or `APP`.",
+					},
+					"access_token": schema.StringAttribute{
+						Optional:            true,
+						Sensitive:           true,
+						MarkdownDescription: "The OAuth 2.0 access token to import. Required for `OAUTH2`.",
+					},
+					"refresh_token": schema.StringAttribute{
+						Optional:            true,
+						Sensitive:           true,
+						MarkdownDescription: "The OAuth 2.0 refresh token to import.",
+					},
+					"expires_at": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "When the imported access token expires, in RFC 3339 format.",
+					},
+					"oauth_token": schema.StringAttribute{
+						Optional:            true,
+						Sensitive:           true,
+						MarkdownDescription: "The OAuth 1.0a token. Required for `OAUTH1`.",
+					},
+					"oauth_token_secret": schema.StringAttribute{
+						Optional:            true,
+						Sensitive:           true,
+						MarkdownDescription: "The OAuth 1.0a token secret. Required for `OAUTH1`.",
+					},
+					"api_key": schema.StringAttribute{
+						Optional:            true,
+						Sensitive:           true,
+						MarkdownDescription: "The API key. Required for `API_KEY`.",
+					},
+					"username": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "The username. Required for `BASIC`.",
+					},
+					"password": schema.StringAttribute{
+						Optional:            true,
+						Sensitive:           true,
+						MarkdownDescription: "The password for `BASIC`.",
+					},
+					"app_id": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "The app ID. Required for `APP`.",
+					},
+					"installation_id": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "The app installation ID. Required for `APP`.",
+					},
+				},
+			},
+			"connection_config": schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Provider specific connection configuration, for example a subdomain. Only the keys set here are checked for drift.",
+			},
+			"end_user": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "The end user the connection belongs to. Nango cannot detach it again, so once set it can be changed but not removed.",
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "Your ID for the end user",
+					},
+					"email": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "The end user's email address",
+					},
+					"display_name": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "The end user's display name",
+					},
+				},
+			},
+			"organization": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "The organization of the end user. Requires `end_user`. Like `end_user`, it can be changed but not removed once set.",
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "Your ID for the organization",
+					},
+					"display_name": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "The organization's display name",
+					},
+				},
+			},
+			"nango_provider": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The Nango provider of the connection's integration",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "When the connection was created",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_fetched_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "When the connection's credentials were last fetched",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"credentials_expires_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "When the current access token expires. Null for credentials that do not expire.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"refresh_failed": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether Nango failed to refresh the connection's credentials. The connection needs to be re-authorized.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"errors": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Errors Nango recorded against the connection",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The kind of error, `auth` or `sync`",
+						},
+						"log_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the log entry describing the error",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *connectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan connectionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.importConnection(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Record the connection before the remaining calls, so that a failure
+	// leaves it tainted in state instead of orphaned in Nango.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("connection_id"), plan.ConnectionId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("provider_config_key"), plan.ProviderConfigKey)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.EndUser != nil {
+		resp.Diagnostics.Append(r.patchEndUser(ctx, plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	connection, err := r.client.GetConnection(ctx, plan.ConnectionId.ValueString(), plan.ProviderConfigKey.ValueString(), nango.GetConnectionOptions{})
+	if err != nil {
+		addNangoError(&resp.Diagnostics, "Unable to Get Connection", err, nil)
+		return
+	}
+
+	resp.Diagnostics.Append(refreshConnectionComputed(ctx, connection, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *connectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state connectionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connection, err := r.client.GetConnection(ctx, state.ConnectionId.ValueString(), state.ProviderConfigKey.ValueString(), nango.GetConnectionOptions{})
+	if nango.IsNotFound(err) {
+		// Deleted outside of Terraform; drop it so the next plan recreates it.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addNangoError(&resp.Diagnostics, "Error Reading Nango Connection "+state.ConnectionId.ValueString(), err, nil)
+		return
+	}
+
+	resp.Diagnostics.Append(refreshConnectionState(ctx, connection, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *connectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state connectionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reimport, diags := connectionNeedsImport(ctx, req.Plan, req.State)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Posting the connection again replaces its credentials and config.
+	if reimport {
+		resp.Diagnostics.Append(r.importConnection(ctx, plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !endUserEqual(plan, state) {
+		resp.Diagnostics.Append(r.patchEndUser(ctx, plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// The computed attributes only change with new credentials, see
+	// ModifyPlan; otherwise the planned prior values are kept.
+	if reimport {
+		connection, err := r.client.GetConnection(ctx, plan.ConnectionId.ValueString(), plan.ProviderConfigKey.ValueString(), nango.GetConnectionOptions{})
+		if err != nil {
+			addNangoError(&resp.Diagnostics, "Unable to Get Connection", err, nil)
+			return
+		}
+
+		resp.Diagnostics.Append(refreshConnectionComputed(ctx, connection, &plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *connectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state connectionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteConnection(ctx, state.ConnectionId.ValueString(), state.ProviderConfigKey.ValueString())
+	// Already gone, e.g. deleted from the dashboard; nothing left to do.
+	if err != nil && !nango.IsNotFound(err) {
+		addNangoError(&resp.Diagnostics, "Unable to Delete Connection", err, nil)
+		return
+	}
+}
+
+// ModifyPlan rejects removing the end user or organization, which Nango
+// cannot detach from a connection. It also marks the computed attributes that
+// depend on the credentials as unknown when the connection is imported again,
+// so that they keep their prior values for every other change.
+func (r *connectionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on create or destroy.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(endUserRemoved(ctx, req.Plan, req.State)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reimport, diags := connectionNeedsImport(ctx, req.Plan, req.State)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || !reimport {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("last_fetched_at"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("credentials_expires_at"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("refresh_failed"), types.BoolUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("errors"), types.ListUnknown(connectionErrorType))...)
+}
+
+// endUserRemoved returns an error for end_user or organization when plan
+// removes it from the connection. Patching the connection without them
+// leaves them in place, so the removal would never converge.
+func endUserRemoved(ctx context.Context, plan tfsdk.Plan, state tfsdk.State) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, name := range []string{"end_user", "organization"} {
+		var planValue, stateValue types.Object
+		readDiags := plan.GetAttribute(ctx, path.Root(name), &planValue)
+		readDiags.Append(state.GetAttribute(ctx, path.Root(name), &stateValue)...)
+		diags.Append(readDiags...)
+		if readDiags.HasError() {
+			return diags
+		}
+		if planValue.IsNull() && !stateValue.IsNull() {
+			diags.AddAttributeError(
+				path.Root(name),
+				"Cannot Remove "+name,
+				"Nango cannot detach the "+name+" from an existing connection. Keep "+name+" in the configuration, "+
+					"or replace the connection with `terraform apply -replace`.",
+			)
+		}
+	}
+	return diags
+}
+
+// connectionNeedsImport reports whether plan changes the credentials or the
+// connection config, which are only updated by importing the connection
+// again.
+func connectionNeedsImport(ctx context.Context, plan tfsdk.Plan, state tfsdk.State) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	for _, name := range []string{"credentials", "connection_config"} {
+		var planValue, stateValue attr.Value
+		diags.Append(plan.GetAttribute(ctx, path.Root(name), &planValue)...)
+		diags.Append(state.GetAttribute(ctx, path.Root(name), &stateValue)...)
+		if diags.HasError() {
+			return false, diags
+		}
+		if !planValue.Equal(stateValue) {
+			return true, diags
+		}
+	}
+	return false, diags
+}
+
+// ValidateConfig checks that the credentials required by the credential
+// type are set and that organization is only used together with end_user.
+func (r *connectionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var credentials types.Object
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("credentials"), &credentials)...)
+	if resp.Diagnostics.HasError() || credentials.IsNull() || credentials.IsUnknown() {
+		return
+	}
+
+	attributes := credentials.Attributes()
+	credentialType, ok := attributes["type"].(types.String)
+	if !ok || credentialType.IsUnknown() {
+		return
+	}
+
+	required, ok := connectionCredentialFields[credentialType.ValueString()]
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("credentials").AtName("type"),
+			"Unsupported Credential Type",
+			"Expected one of OAUTH2, OAUTH1, API_KEY, BASIC or APP, got: "+credentialType.ValueString()+".",
+		)
+		return
+	}
+	for _, name := range required {
+		if attributes[name].IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("credentials").AtName(name),
+				"Missing Credential",
+				name+" is required for "+credentialType.ValueString()+" credentials.",
+			)
+		}
+	}
+
+	var endUser, organization types.Object
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("end_user"), &endUser)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("organization"), &organization)...)
+	if !organization.IsNull() && endUser.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("organization"),
+			"Missing End User",
+			"organization can only be set together with end_user.",
+		)
+	}
+}
+
+// importConnection posts the planned credentials and connection config.
+func (r *connectionResource) importConnection(ctx context.Context, plan connectionResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	request := nango.ImportConnectionRequest{
+		ProviderConfigKey: plan.ProviderConfigKey.ValueString(),
+		ConnectionID:      plan.ConnectionId.ValueString(),
+	}
+	if !plan.ConnectionConfig.IsNull() {
+		diags.Append(plan.ConnectionConfig.ElementsAs(ctx, &request.ConnectionConfig, false)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+	if credentials := plan.Credentials; credentials != nil {
+		request.AccessToken = credentials.AccessToken.ValueString()
+		request.RefreshToken = credentials.RefreshToken.ValueString()
+		request.ExpiresAt = credentials.ExpiresAt.ValueString()
+		request.OAuthToken = credentials.OAuthToken.ValueString()
+		request.OAuthTokenSecret = credentials.OAuthTokenSecret.ValueString()
+		request.APIKey = credentials.ApiKey.ValueString()
+		request.Username = credentials.Username.ValueString()
+		request.Password = credentials.Password.ValueString()
+		request.AppID = credentials.AppId.ValueString()
+		request.InstallationID = credentials.InstallationId.ValueString()
+	}
+
+	if err := r.client.ImportConnection(ctx, request); err != nil {
+		addNangoError(&diags, "Unable to Import Connection", err, connectionAttributePath)
+	}
+	return diags
+}
+
+// patchEndUser sets the planned end user and organization on the connection.
+func (r *connectionResource) patchEndUser(ctx context.Context, plan connectionResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	request := nango.PatchConnectionRequest{}
+	if plan.EndUser != nil {
+		request.EndUser = &nango.EndUser{
+			ID:          plan.EndUser.Id.ValueString(),
+			Email:       plan.EndUser.Email.ValueString(),
+			DisplayName: plan.EndUser.DisplayName.ValueString(),
+		}
+		if plan.Organization != nil {
+			request.EndUser.Organization = &nango.Organization{
+				ID:          plan.Organization.Id.ValueString(),
+				DisplayName: plan.Organization.DisplayName.ValueString(),
+			}
+		}
+	}
+
+	err := r.client.PatchConnection(ctx, plan.ConnectionId.ValueString(), plan.ProviderConfigKey.ValueString(), request)
+	if err != nil {
+		addNangoError(&diags, "Unable to Update Connection End User", err, connectionAttributePath)
+	}
+	return diags
+}
+
+// endUserEqual reports whether the end user and organization are unchanged.
+func endUserEqual(plan, state connectionResourceModel) bool {
+	if (plan.EndUser == nil) != (state.EndUser == nil) || (plan.Organization == nil) != (state.Organization == nil) {
+		return false
+	}
+	if plan.EndUser != nil && (!plan.EndUser.Id.Equal(state.EndUser.Id) ||
+		!plan.EndUser.Email.Equal(state.EndUser.Email) ||
+		!plan.EndUser.DisplayName.Equal(state.EndUser.DisplayName)) {
+		return false
+	}
+	if plan.Organization != nil && (!plan.Organization.Id.Equal(state.Organization.Id) ||
+		!plan.Organization.DisplayName.Equal(state.Organization.DisplayName)) {
+		return false
+	}
+	return true
+}
+
+// refreshConnectionState copies what Nango returns for a connection into
+// state. Credentials are not read back: Nango refreshes OAuth tokens on its
+// own, so only their refresh status is tracked.
+func refreshConnectionState(ctx context.Context, connection *nango.Connection, state *connectionResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	state.ConnectionId = types.StringValue(connection.ConnectionID)
+	state.ProviderConfigKey = types.StringValue(connection.ProviderConfigKey)
+
+	// Nango adds its own keys to connection_config, so only the keys managed
+	// here are compared.
+	if !state.ConnectionConfig.IsNull() {
+		managed := map[string]string{}
+		for key := range state.ConnectionConfig.Elements() {
+			if value, ok := connection.ConnectionConfig[key]; ok {
+				managed[key] = fmt.Sprint(value)
+			}
+		}
+		var mapDiags diag.Diagnostics
+		state.ConnectionConfig, mapDiags = types.MapValueFrom(ctx, types.StringType, managed)
+		diags.Append(mapDiags...)
+	}
+
+	state.EndUser = nil
+	state.Organization = nil
+	if connection.EndUser != nil {
+		state.EndUser = &endUserModel{
+			Id:          types.StringValue(connection.EndUser.ID),
+			Email:       optionalString(connection.EndUser.Email),
+			DisplayName: optionalString(connection.EndUser.DisplayName),
+		}
+		if organization := connection.EndUser.Organization; organization != nil {
+			state.Organization = &organizationModel{
+				Id:          types.StringValue(organization.ID),
+				DisplayName: optionalString(organization.DisplayName),
+			}
+		}
+	}
+
+	diags.Append(refreshConnectionComputed(ctx, connection, state)...)
+	return diags
+}
+
+// refreshConnectionComputed sets the computed attributes of a connection.
+func refreshConnectionComputed(ctx context.Context, connection *nango.Connection, state *connectionResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	state.NangoProvider = types.StringValue(connection.Provider)
+	state.CreatedAt = types.StringValue(connection.CreatedAt)
+	state.LastFetchedAt = optionalString(connection.LastFetchedAt)
+
+	state.CredentialsExpiresAt = types.StringNull()
+	if connection.Credentials != nil {
+		state.CredentialsExpiresAt = optionalString(connection.Credentials.ExpiresAt)
+	}
+
+	refreshFailed := false
+	errors := make([]connectionErrorModel, 0, len(connection.Errors))
+	for _, e := range connection.Errors {
+		if e.Type == nango.ConnectionErrorAuth {
+			refreshFailed = true
+		}
+		errors = append(errors, connectionErrorModel{
+			Type:  types.StringValue(e.Type),
+			LogId: types.StringValue(e.LogID),
+		})
+	}
+	state.RefreshFailed = types.BoolValue(refreshFailed)
+
+	var listDiags diag.Diagnostics
+	state.Errors, listDiags = types.ListValueFrom(ctx, connectionErrorType, errors)
+	diags.Append(listDiags...)
+
+	return diags
+}
+
+// connectionAttributePath maps fields of the connection request bodies to
+// their schema attributes.
+func connectionAttributePath(field []string) (path.Path, bool) {
+	if len(field) == 0 {
+		return path.Empty(), false
+	}
+
+	switch field[0] {
+	case "connection_id", "provider_config_key", "connection_config":
+		return path.Root(field[0]), true
+	case "access_token", "refresh_token", "expires_at", "oauth_token", "oauth_token_secret", "api_key", "username", "password", "app_id", "installation_id":
+		return path.Root("credentials").AtName(field[0]), true
+	case "end_user":
+		if len(field) > 1 && field[1] == "organization" {
+			return path.Root("organization"), true
+		}
+		return path.Root("end_user"), true
+	}
+	return path.Empty(), false
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *connectionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*nango.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *nango.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ImportState imports the resource into Terraform state.
+func (r *connectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// The import ID is <provider_config_key>/<connection_id>
+	providerConfigKey, connectionID, ok := strings.Cut(req.ID, "/")
+	if !ok || providerConfigKey == "" || connectionID == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			"Expected an import ID of the form <provider_config_key>/<connection_id>, got: "+req.ID,
+		)
+		return
+	}
+
+	connection, err := r.client.GetConnection(ctx, connectionID, providerConfigKey, nango.GetConnectionOptions{})
+	if err != nil {
+		addNangoError(&resp.Diagnostics, "Unable to Import Connection "+req.ID, err, nil)
+		return
+	}
+
+	state := connectionResourceModel{
+		ConnectionConfig: types.MapNull(types.StringType),
+	}
+	resp.Diagnostics.Append(refreshConnectionState(ctx, connection, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Static credentials can be read back as-is. OAuth tokens rotate, so
+	// they are left for the configuration to supply.
+	if credentials := connection.Credentials; credentials != nil {
+		switch credentials.Type {
+		case "API_KEY":
+			state.Credentials = newConnectionCredentials(credentials.Type)
+			state.Credentials.ApiKey = types.StringValue(credentials.APIKey)
+		case "BASIC":
+			state.Credentials = newConnectionCredentials(credentials.Type)
+			state.Credentials.Username = types.StringValue(credentials.Username)
+			state.Credentials.Password = optionalString(credentials.Password)
+		default:
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("credentials"),
+				"Credentials Not Imported",
+				"Connection "+req.ID+" uses "+credentials.Type+" credentials, which are not imported. "+
+					"The first apply after import will write the configured credentials to Nango.",
+			)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// newConnectionCredentials returns credentials of the given type with every
+// other attribute null.
+func newConnectionCredentials(credentialType string) *connectionCredentialsModel {
+	return &connectionCredentialsModel{
+		Type:             types.StringValue(credentialType),
+		AccessToken:      types.StringNull(),
+		RefreshToken:     types.StringNull(),
+		ExpiresAt:        types.StringNull(),
+		OAuthToken:       types.StringNull(),
+		OAuthTokenSecret: types.StringNull(),
+		ApiKey:           types.StringNull(),
+		Username:         types.StringNull(),
+		Password:         types.StringNull(),
+		AppId:            types.StringNull(),
+		InstallationId:   types.StringNull(),
+	}
+}
@@ -0,0 +1,259 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-nango/internal/nango"
+)
+
+func testConnectionState() connectionResourceModel {
+	credentials := newConnectionCredentials("API_KEY")
+	credentials.ApiKey = types.StringValue("key")
+	return connectionResourceModel{
+		ConnectionId:         types.StringValue("acme"),
+		ProviderConfigKey:    types.StringValue("github"),
+		Credentials:          credentials,
+		ConnectionConfig:     types.MapNull(types.StringType),
+		EndUser:              &endUserModel{Id: types.StringValue("user-1"), Email: types.StringNull(), DisplayName: types.StringNull()},
+		Organization:         &organizationModel{Id: types.StringValue("org-1"), DisplayName: types.StringNull()},
+		NangoProvider:        types.StringValue("github"),
+		CreatedAt:            types.StringValue("2024-01-01T00:00:00Z"),
+		LastFetchedAt:        types.StringNull(),
+		CredentialsExpiresAt: types.StringNull(),
+		RefreshFailed:        types.BoolValue(false),
+		Errors:               types.ListValueMust(connectionErrorType, nil),
+	}
+}
+
+func TestEndUserRemoved(t *testing.T) {
+	ctx := context.Background()
+	var schemaResp resource.SchemaResponse
+	(&connectionResource{}).Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	tests := map[string]struct {
+		modify    func(plan *connectionResourceModel)
+		wantPaths []string
+	}{
+		"unchanged":            {modify: func(*connectionResourceModel) {}},
+		"changed end user":     {modify: func(plan *connectionResourceModel) { plan.EndUser.Id = types.StringValue("user-2") }},
+		"removed organization": {modify: func(plan *connectionResourceModel) { plan.Organization = nil }, wantPaths: []string{"organization"}},
+		"removed both": {
+			modify:    func(plan *connectionResourceModel) { plan.EndUser, plan.Organization = nil, nil },
+			wantPaths: []string{"end_user", "organization"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			prior := testConnectionState()
+			planned := testConnectionState()
+			tt.modify(&planned)
+
+			state := tfsdk.State{Schema: schemaResp.Schema}
+			plan := tfsdk.Plan{Schema: schemaResp.Schema}
+			if diags := state.Set(ctx, &prior); diags.HasError() {
+				t.Fatalf("setting state: %v", diags)
+			}
+			if diags := plan.Set(ctx, &planned); diags.HasError() {
+				t.Fatalf("setting plan: %v", diags)
+			}
+
+			diags := endUserRemoved(ctx, plan, state)
+			var paths []string
+			for _, d := range diags.Errors() {
+				paths = append(paths, d.(diag.DiagnosticWithPath).Path().String())
+			}
+			if len(paths) != len(tt.wantPaths) {
+				t.Fatalf("errors on %v, want %v", paths, tt.wantPaths)
+			}
+			for i := range paths {
+				if paths[i] != tt.wantPaths[i] {
+					t.Errorf("errors on %v, want %v", paths, tt.wantPaths)
+				}
+			}
+		})
+	}
+}
+
+func TestRefreshConnectionState(t *testing.T) {
+	ctx := context.Background()
+
+	tests := map[string]struct {
+		connection        nango.Connection
+		config            types.Map
+		wantConfig        types.Map
+		wantEndUser       *endUserModel
+		wantOrganization  *organizationModel
+		wantRefreshFailed bool
+		wantErrors        int
+		wantExpiresAt     types.String
+	}{
+		"minimal": {
+			connection:    nango.Connection{},
+			config:        types.MapNull(types.StringType),
+			wantConfig:    types.MapNull(types.StringType),
+			wantExpiresAt: types.StringNull(),
+		},
+		"only managed config keys": {
+			connection:    nango.Connection{ConnectionConfig: map[string]any{"subdomain": "acme", "instance_url": "https://acme"}},
+			config:        types.MapValueMust(types.StringType, map[string]attr.Value{"subdomain": types.StringValue("old")}),
+			wantConfig:    types.MapValueMust(types.StringType, map[string]attr.Value{"subdomain": types.StringValue("acme")}),
+			wantExpiresAt: types.StringNull(),
+		},
+		"end user and organization": {
+			connection: nango.Connection{EndUser: &nango.EndUser{
+				ID:           "user-1",
+				Email:        "user@example.com",
+				Organization: &nango.Organization{ID: "org-1"},
+			}},
+			config:           types.MapNull(types.StringType),
+			wantConfig:       types.MapNull(types.StringType),
+			wantEndUser:      &endUserModel{Id: types.StringValue("user-1"), Email: types.StringValue("user@example.com"), DisplayName: types.StringNull()},
+			wantOrganization: &organizationModel{Id: types.StringValue("org-1"), DisplayName: types.StringNull()},
+			wantExpiresAt:    types.StringNull(),
+		},
+		"auth error": {
+			connection: nango.Connection{
+				Errors:      []nango.ConnectionError{{Type: nango.ConnectionErrorSync, LogID: "1"}, {Type: nango.ConnectionErrorAuth, LogID: "2"}},
+				Credentials: &nango.ConnectionCredentials{Type: "OAUTH2", ExpiresAt: "2024-01-01T00:00:00Z"},
+			},
+			config:            types.MapNull(types.StringType),
+			wantConfig:        types.MapNull(types.StringType),
+			wantRefreshFailed: true,
+			wantErrors:        2,
+			wantExpiresAt:     types.StringValue("2024-01-01T00:00:00Z"),
+		},
+		"sync error": {
+			connection:    nango.Connection{Errors: []nango.ConnectionError{{Type: nango.ConnectionErrorSync, LogID: "1"}}},
+			config:        types.MapNull(types.StringType),
+			wantConfig:    types.MapNull(types.StringType),
+			wantErrors:    1,
+			wantExpiresAt: types.StringNull(),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			state := testConnectionState()
+			state.ConnectionConfig = tt.config
+
+			if diags := refreshConnectionState(ctx, &tt.connection, &state); diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if !state.ConnectionConfig.Equal(tt.wantConfig) {
+				t.Errorf("connection_config = %v, want %v", state.ConnectionConfig, tt.wantConfig)
+			}
+			if !reflect.DeepEqual(state.EndUser, tt.wantEndUser) {
+				t.Errorf("end_user = %+v, want %+v", state.EndUser, tt.wantEndUser)
+			}
+			if !reflect.DeepEqual(state.Organization, tt.wantOrganization) {
+				t.Errorf("organization = %+v, want %+v", state.Organization, tt.wantOrganization)
+			}
+			if got := state.RefreshFailed.ValueBool(); got != tt.wantRefreshFailed {
+				t.Errorf("refresh_failed = %t, want %t", got, tt.wantRefreshFailed)
+			}
+			if got := len(state.Errors.Elements()); got != tt.wantErrors {
+				t.Errorf("errors has %d elements, want %d", got, tt.wantErrors)
+			}
+			if !state.CredentialsExpiresAt.Equal(tt.wantExpiresAt) {
+				t.Errorf("credentials_expires_at = %v, want %v", state.CredentialsExpiresAt, tt.wantExpiresAt)
+			}
+		})
+	}
+}
+
+func TestConnectionImportState(t *testing.T) {
+	ctx := context.Background()
+
+	tests := map[string]struct {
+		id              string
+		credentials     *nango.ConnectionCredentials
+		wantError       bool
+		wantWarning     bool
+		wantCredentials *connectionCredentialsModel
+	}{
+		"invalid id": {
+			id:        "acme",
+			wantError: true,
+		},
+		"api key": {
+			id:          "github/acme",
+			credentials: &nango.ConnectionCredentials{Type: "API_KEY", APIKey: "key"},
+			wantCredentials: func() *connectionCredentialsModel {
+				c := newConnectionCredentials("API_KEY")
+				c.ApiKey = types.StringValue("key")
+				return c
+			}(),
+		},
+		"basic": {
+			id:          "github/acme",
+			credentials: &nango.ConnectionCredentials{Type: "BASIC", Username: "user"},
+			wantCredentials: func() *connectionCredentialsModel {
+				c := newConnectionCredentials("BASIC")
+				c.Username = types.StringValue("user")
+				return c
+			}(),
+		},
+		"oauth2": {
+			id:          "github/acme",
+			credentials: &nango.ConnectionCredentials{Type: "OAUTH2", AccessToken: "token"},
+			wantWarning: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := &connectionResource{client: testNangoClient(t, func(w http.ResponseWriter, req *http.Request) {
+				if req.URL.Path != "/connection/acme" || req.URL.Query().Get("provider_config_key") != "github" {
+					t.Errorf("unexpected request %s %s", req.Method, req.URL)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(nango.Connection{
+					ConnectionID:      "acme",
+					ProviderConfigKey: "github",
+					Provider:          "github",
+					Credentials:       tt.credentials,
+				})
+			})}
+
+			var schemaResp resource.SchemaResponse
+			r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+			resp := resource.ImportStateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+			r.ImportState(ctx, resource.ImportStateRequest{ID: tt.id}, &resp)
+
+			if got := resp.Diagnostics.HasError(); got != tt.wantError {
+				t.Fatalf("HasError = %t, want %t: %v", got, tt.wantError, resp.Diagnostics)
+			}
+			if tt.wantError {
+				return
+			}
+			if got := resp.Diagnostics.WarningsCount() > 0; got != tt.wantWarning {
+				t.Errorf("warnings = %v, want warning %t", resp.Diagnostics.Warnings(), tt.wantWarning)
+			}
+
+			var state connectionResourceModel
+			if diags := resp.State.Get(ctx, &state); diags.HasError() {
+				t.Fatalf("reading state: %v", diags)
+			}
+			if state.ConnectionId.ValueString() != "acme" || state.ProviderConfigKey.ValueString() != "github" {
+				t.Errorf("imported %s/%s, want github/acme", state.ProviderConfigKey, state.ConnectionId)
+			}
+			if !reflect.DeepEqual(state.Credentials, tt.wantCredentials) {
+				t.Errorf("credentials = %+v, want %+v", state.Credentials, tt.wantCredentials)
+			}
+		})
+	}
+}
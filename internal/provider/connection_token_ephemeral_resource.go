@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-nango/internal/nango"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ ephemeral.EphemeralResource              = &connectionTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &connectionTokenEphemeralResource{}
+)
+
+// NewConnectionTokenEphemeralResource is a helper function to simplify the provider implementation.
+func NewConnectionTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &connectionTokenEphemeralResource{}
+}
+
+// connectionTokenModel maps the nango_connection_token ephemeral resource schema data.
+type connectionTokenModel struct {
+	ConnectionId        types.String `tfsdk:"connection_id"`
+	ProviderConfigKey   types.String `tfsdk:"provider_config_key"`
+	ForceRefresh        types.Bool   `tfsdk:"force_refresh"`
+	IncludeRefreshToken types.Bool   `tfsdk:"include_refresh_token"`
+	Type                types.String `tfsdk:"type"`
+	AccessToken         types.String `tfsdk:"access_token"`
+	RefreshToken        types.String `tfsdk:"refresh_token"`
+	ExpiresAt           types.String `tfsdk:"expires_at"`
+}
+
+// connectionTokenEphemeralResource is the ephemeral resource implementation.
+type connectionTokenEphemeralResource struct {
+	client *nango.Client
+}
+
+// Metadata returns the ephemeral resource type name.
+func (e *connectionTokenEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connection_token"
+}
+
+// Schema defines the schema for the ephemeral resource.
+func (e *connectionTokenEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches the current OAuth access token Nango holds for a connection, refreshing it first if it has expired. The token is only available during the current Terraform run and is never stored in state or plan.",
+		Attributes: map[string]schema.Attribute{
+			"connection_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The connection ID",
+			},
+			"provider_config_key": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The `unique_key` of the connection's integration",
+			},
+			"force_refresh": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Refresh the access token even if it has not expired",
+			},
+			"include_refresh_token": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Also return the refresh token",
+			},
+			"type": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The type of credential, for example `OAUTH2`",
+			},
+			"access_token": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The access token",
+			},
+			"refresh_token": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The refresh token. Only set when `include_refresh_token` is `true`.",
+			},
+			"expires_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "When the access token expires. Null for tokens that do not expire.",
+			},
+		},
+	}
+}
+
+// Open fetches the connection's credentials.
+func (e *connectionTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data connectionTokenModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connectionID := data.ConnectionId.ValueString()
+	connection, err := e.client.GetConnection(ctx, connectionID, data.ProviderConfigKey.ValueString(), nango.GetConnectionOptions{
+		ForceRefresh: data.ForceRefresh.ValueBool(),
+		RefreshToken: data.IncludeRefreshToken.ValueBool(),
+	})
+	if err != nil {
+		addNangoError(&resp.Diagnostics, "Unable to Get Connection "+connectionID, err, nil)
+		return
+	}
+
+	credentials := connection.Credentials
+	if credentials == nil || credentials.AccessToken == "" {
+		resp.Diagnostics.AddError(
+			"Connection Has No Access Token",
+			"Connection "+connectionID+" does not hold an OAuth access token.",
+		)
+		return
+	}
+
+	data.Type = types.StringValue(credentials.Type)
+	data.AccessToken = types.StringValue(credentials.AccessToken)
+	data.RefreshToken = optionalString(credentials.RefreshToken)
+	data.ExpiresAt = optionalString(credentials.ExpiresAt)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// Configure adds the provider configured client to the ephemeral resource.
+func (e *connectionTokenEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*nango.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *nango.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	e.client = client
+}
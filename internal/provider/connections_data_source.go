@@ -0,0 +1,215 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-nango/internal/nango"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &connectionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &connectionsDataSource{}
+)
+
+type connectionsDataSource struct {
+	client *nango.Client
+}
+
+type connectionsDataSourceModel struct {
+	Search            types.String      `tfsdk:"search"`
+	ProviderConfigKey types.String      `tfsdk:"provider_config_key"`
+	EndUserId         types.String      `tfsdk:"end_user_id"`
+	OrganizationId    types.String      `tfsdk:"organization_id"`
+	Connections       []connectionModel `tfsdk:"connections"`
+}
+
+type connectionModel struct {
+	ConnectionId      types.String           `tfsdk:"connection_id"`
+	ProviderConfigKey types.String           `tfsdk:"provider_config_key"`
+	NangoProvider     types.String           `tfsdk:"nango_provider"`
+	CreatedAt         types.String           `tfsdk:"created_at"`
+	EndUserId         types.String           `tfsdk:"end_user_id"`
+	OrganizationId    types.String           `tfsdk:"organization_id"`
+	Errors            []connectionErrorModel `tfsdk:"errors"`
+	Metadata          types.String           `tfsdk:"metadata"`
+}
+
+// NewConnectionsDataSource is a helper function to simplify the provider implementation.
+func NewConnectionsDataSource() datasource.DataSource {
+	return &connectionsDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *connectionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connections"
+}
+
+// Schema defines the schema for the data source.
+func (d *connectionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the connections in the Nango environment. All pages are fetched.",
+		Attributes: map[string]schema.Attribute{
+			"search": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return connections whose ID or end user matches this search term.",
+			},
+			"provider_config_key": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return connections of the integration with this `unique_key`.",
+			},
+			"end_user_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return connections of this end user.",
+			},
+			"organization_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return connections of end users in this organization.",
+			},
+			"connections": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"connection_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The connection ID",
+						},
+						"provider_config_key": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The `unique_key` of the connection's integration",
+						},
+						"nango_provider": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The nango_provider",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "When the connection was created",
+						},
+						"end_user_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the connection's end user",
+						},
+						"organization_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the end user's organization",
+						},
+						"errors": schema.ListNestedAttribute{
+							Computed:            true,
+							MarkdownDescription: "Errors Nango recorded against the connection",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"type": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The kind of error, `auth` or `sync`",
+									},
+									"log_id": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The ID of the log entry describing the error",
+									},
+								},
+							},
+						},
+						"metadata": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The connection's metadata as a JSON object. Use `jsondecode` to read it.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *connectionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state connectionsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connections, err := d.client.ListConnections(ctx, nango.ListConnectionsOptions{
+		Search:                state.Search.ValueString(),
+		IntegrationID:         state.ProviderConfigKey.ValueString(),
+		EndUserID:             state.EndUserId.ValueString(),
+		EndUserOrganizationID: state.OrganizationId.ValueString(),
+	})
+	if err != nil {
+		addNangoError(&resp.Diagnostics, "Unable to Read Connections", err, nil)
+		return
+	}
+
+	state.Connections = []connectionModel{}
+	for _, connection := range connections {
+		metadata := connection.Metadata
+		if metadata == nil {
+			metadata = map[string]any{}
+		}
+		metadataJSON, err := json.Marshal(metadata)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Encode Connection Metadata",
+				"Metadata of connection "+connection.ConnectionID+" could not be encoded: "+err.Error(),
+			)
+			return
+		}
+
+		connectionState := connectionModel{
+			ConnectionId:      types.StringValue(connection.ConnectionID),
+			ProviderConfigKey: types.StringValue(connection.ProviderConfigKey),
+			NangoProvider:     types.StringValue(connection.Provider),
+			CreatedAt:         types.StringValue(connection.Created),
+			EndUserId:         types.StringNull(),
+			OrganizationId:    types.StringNull(),
+			Errors:            []connectionErrorModel{},
+			Metadata:          types.StringValue(string(metadataJSON)),
+		}
+		if endUser := connection.EndUser; endUser != nil {
+			connectionState.EndUserId = optionalString(endUser.ID)
+			if endUser.Organization != nil {
+				connectionState.OrganizationId = optionalString(endUser.Organization.ID)
+			}
+		}
+		for _, e := range connection.Errors {
+			connectionState.Errors = append(connectionState.Errors, connectionErrorModel{
+				Type:  types.StringValue(e.Type),
+				LogId: types.StringValue(e.LogID),
+			})
+		}
+
+		state.Connections = append(state.Connections, connectionState)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *connectionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*nango.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *nango.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
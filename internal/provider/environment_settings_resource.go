@@ -0,0 +1,330 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-nango/internal/nango"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &environmentSettingsResource{}
+	_ resource.ResourceWithConfigure   = &environmentSettingsResource{}
+	_ resource.ResourceWithImportState = &environmentSettingsResource{}
+)
+
+// NewEnvironmentSettingsResource is a helper function to simplify the provider implementation.
+func NewEnvironmentSettingsResource() resource.Resource {
+	return &environmentSettingsResource{}
+}
+
+// environmentSettingsResourceModel maps the nango_environment_settings resource schema data.
+type environmentSettingsResourceModel struct {
+	Name                types.String `tfsdk:"name"`
+	CallbackURL         types.String `tfsdk:"callback_url"`
+	WebhookURL          types.String `tfsdk:"webhook_url"`
+	SecondaryWebhookURL types.String `tfsdk:"secondary_webhook_url"`
+	SendAuthWebhook     types.Bool   `tfsdk:"send_auth_webhook"`
+	AlwaysSendWebhook   types.Bool   `tfsdk:"always_send_webhook"`
+	HMACEnabled         types.Bool   `tfsdk:"hmac_enabled"`
+	HMACKey             types.String `tfsdk:"hmac_key"`
+}
+
+// environmentSettingsResource is the resource implementation. The
+// environment always exists, so the resource only ever updates it.
+type environmentSettingsResource struct {
+	client *nango.Client
+}
+
+// Metadata returns the resource type name.
+func (r *environmentSettingsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_environment_settings"
+}
+
+// Schema defines the schema for the resource.
+func (r *environmentSettingsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the settings of the Nango environment behind the provider's `environment_key`. Declare it at most once per environment. Settings left unset keep their current value, and destroying the resource leaves the settings as they are.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The environment name, for example `prod`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"callback_url": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The OAuth callback URL registered with providers",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"webhook_url": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The primary URL Nango sends webhooks to. Set to `\"\"` to disable it.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"secondary_webhook_url": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The secondary URL Nango sends webhooks to. Set to `\"\"` to disable it.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"send_auth_webhook": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether Nango sends a webhook when a connection is created or its authorization fails",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"always_send_webhook": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether Nango sends sync webhooks even when a run changed no records",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"hmac_enabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether connections must be created with an HMAC signature",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"hmac_key": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The key HMAC signatures are computed with",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Create applies the configured settings and sets the initial Terraform state.
+func (r *environmentSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan environmentSettingsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	environment, err := r.client.PatchEnvironment(ctx, environmentPatch(plan))
+	if err != nil {
+		addNangoError(&resp.Diagnostics, "Unable to Update Environment Settings", err, environmentAttributePath)
+		return
+	}
+	fillEnvironmentState(environment, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *environmentSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state environmentSettingsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	environment, err := r.client.GetEnvironment(ctx)
+	if err != nil {
+		addNangoError(&resp.Diagnostics, "Unable to Read Environment Settings", err, nil)
+		return
+	}
+	refreshEnvironmentState(environment, &state)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *environmentSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan environmentSettingsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	environment, err := r.client.PatchEnvironment(ctx, environmentPatch(plan))
+	if err != nil {
+		addNangoError(&resp.Diagnostics, "Unable to Update Environment Settings", err, environmentAttributePath)
+		return
+	}
+	fillEnvironmentState(environment, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes the resource from Terraform state. The environment and its
+// settings are left as they are.
+func (r *environmentSettingsResource) Delete(_ context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.AddWarning(
+		"Environment Settings Left in Place",
+		"The Nango environment cannot be deleted, so its settings keep their current values. "+
+			"The resource was only removed from Terraform state.",
+	)
+}
+
+// environmentPatch builds a request that sets every known planned setting.
+// Settings not in the configuration are unknown and therefore left alone.
+func environmentPatch(plan environmentSettingsResourceModel) nango.PatchEnvironmentRequest {
+	request := nango.PatchEnvironmentRequest{}
+	if !plan.CallbackURL.IsUnknown() {
+		request.CallbackURL = plan.CallbackURL.ValueStringPointer()
+	}
+	if !plan.WebhookURL.IsUnknown() {
+		request.WebhookURL = plan.WebhookURL.ValueStringPointer()
+	}
+	if !plan.SecondaryWebhookURL.IsUnknown() {
+		request.WebhookURLSecondary = plan.SecondaryWebhookURL.ValueStringPointer()
+	}
+	if !plan.SendAuthWebhook.IsUnknown() {
+		request.SendAuthWebhook = plan.SendAuthWebhook.ValueBoolPointer()
+	}
+	if !plan.AlwaysSendWebhook.IsUnknown() {
+		request.AlwaysSendWebhook = plan.AlwaysSendWebhook.ValueBoolPointer()
+	}
+	if !plan.HMACEnabled.IsUnknown() {
+		request.HMACEnabled = plan.HMACEnabled.ValueBoolPointer()
+	}
+	if !plan.HMACKey.IsUnknown() {
+		request.HMACKey = plan.HMACKey.ValueStringPointer()
+	}
+	return request
+}
+
+// refreshEnvironmentState copies every setting Nango returns into state so
+// that changes made in the dashboard show up as a diff.
+func refreshEnvironmentState(environment *nango.Environment, state *environmentSettingsResourceModel) {
+	state.Name = types.StringValue(environment.Name)
+	state.CallbackURL = types.StringValue(environment.CallbackURL)
+	state.WebhookURL = types.StringValue(environment.WebhookURL)
+	state.SecondaryWebhookURL = types.StringValue(environment.WebhookURLSecondary)
+	state.SendAuthWebhook = types.BoolValue(environment.SendAuthWebhook)
+	state.AlwaysSendWebhook = types.BoolValue(environment.AlwaysSendWebhook)
+	state.HMACEnabled = types.BoolValue(environment.HMACEnabled)
+	state.HMACKey = types.StringValue(environment.HMACKey)
+}
+
+// fillEnvironmentState sets the settings left unknown in plan, those not in
+// the configuration, to the values Nango returns. Configured values are kept
+// as planned.
+func fillEnvironmentState(environment *nango.Environment, plan *environmentSettingsResourceModel) {
+	var refreshed environmentSettingsResourceModel
+	refreshEnvironmentState(environment, &refreshed)
+
+	if plan.Name.IsUnknown() {
+		plan.Name = refreshed.Name
+	}
+	if plan.CallbackURL.IsUnknown() {
+		plan.CallbackURL = refreshed.CallbackURL
+	}
+	if plan.WebhookURL.IsUnknown() {
+		plan.WebhookURL = refreshed.WebhookURL
+	}
+	if plan.SecondaryWebhookURL.IsUnknown() {
+		plan.SecondaryWebhookURL = refreshed.SecondaryWebhookURL
+	}
+	if plan.SendAuthWebhook.IsUnknown() {
+		plan.SendAuthWebhook = refreshed.SendAuthWebhook
+	}
+	if plan.AlwaysSendWebhook.IsUnknown() {
+		plan.AlwaysSendWebhook = refreshed.AlwaysSendWebhook
+	}
+	if plan.HMACEnabled.IsUnknown() {
+		plan.HMACEnabled = refreshed.HMACEnabled
+	}
+	if plan.HMACKey.IsUnknown() {
+		plan.HMACKey = refreshed.HMACKey
+	}
+}
+
+// environmentAttributePath maps fields of the environment request body to
+// their schema attributes.
+func environmentAttributePath(field []string) (path.Path, bool) {
+	if len(field) == 0 {
+		return path.Empty(), false
+	}
+
+	switch field[0] {
+	case "callback_url", "webhook_url", "send_auth_webhook", "always_send_webhook", "hmac_enabled", "hmac_key":
+		return path.Root(field[0]), true
+	case "webhook_url_secondary":
+		return path.Root("secondary_webhook_url"), true
+	}
+	return path.Empty(), false
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *environmentSettingsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*nango.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *nango.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ImportState imports the resource into Terraform state. There is one
+// environment per key, so the import ID is only checked against its name.
+func (r *environmentSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	environment, err := r.client.GetEnvironment(ctx)
+	if err != nil {
+		addNangoError(&resp.Diagnostics, "Unable to Import Environment Settings", err, nil)
+		return
+	}
+	if req.ID != environment.Name {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			"The provider's environment_key belongs to environment "+environment.Name+", got: "+req.ID,
+		)
+		return
+	}
+
+	var state environmentSettingsResourceModel
+	refreshEnvironmentState(environment, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
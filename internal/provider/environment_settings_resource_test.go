@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-nango/internal/nango"
+)
+
+func TestFillEnvironmentState(t *testing.T) {
+	plan := environmentSettingsResourceModel{
+		Name:                types.StringUnknown(),
+		CallbackURL:         types.StringUnknown(),
+		WebhookURL:          types.StringValue("https://example.com/hooks"),
+		SecondaryWebhookURL: types.StringValue(""),
+		SendAuthWebhook:     types.BoolUnknown(),
+		AlwaysSendWebhook:   types.BoolValue(false),
+		HMACEnabled:         types.BoolUnknown(),
+		HMACKey:             types.StringUnknown(),
+	}
+
+	fillEnvironmentState(&nango.Environment{
+		Name:                "prod",
+		CallbackURL:         "https://api.nango.dev/oauth/callback",
+		WebhookURL:          "https://example.com/hooks/",
+		WebhookURLSecondary: "https://example.com/other",
+		SendAuthWebhook:     true,
+		AlwaysSendWebhook:   true,
+		HMACKey:             "key",
+	}, &plan)
+
+	want := environmentSettingsResourceModel{
+		Name:                types.StringValue("prod"),
+		CallbackURL:         types.StringValue("https://api.nango.dev/oauth/callback"),
+		WebhookURL:          types.StringValue("https://example.com/hooks"),
+		SecondaryWebhookURL: types.StringValue(""),
+		SendAuthWebhook:     types.BoolValue(true),
+		AlwaysSendWebhook:   types.BoolValue(false),
+		HMACEnabled:         types.BoolValue(false),
+		HMACKey:             types.StringValue("key"),
+	}
+	if plan != want {
+		t.Errorf("got %+v, want %+v", plan, want)
+	}
+}
@@ -0,0 +1,226 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-nango/internal/nango"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &environmentVariableResource{}
+	_ resource.ResourceWithConfigure   = &environmentVariableResource{}
+	_ resource.ResourceWithImportState = &environmentVariableResource{}
+)
+
+// environmentVariablesMu serializes changes to environment variables. Nango
+// only accepts the full list of variables, so each change reads the list,
+// edits it and writes it back; concurrent resources would lose updates.
+var environmentVariablesMu sync.Mutex
+
+// NewEnvironmentVariableResource is a helper function to simplify the provider implementation.
+func NewEnvironmentVariableResource() resource.Resource {
+	return &environmentVariableResource{}
+}
+
+// environmentVariableResourceModel maps the nango_environment_variable resource schema data.
+type environmentVariableResourceModel struct {
+	Name  types.String `tfsdk:"name"`
+	Value types.String `tfsdk:"value"`
+}
+
+// environmentVariableResource is the resource implementation.
+type environmentVariableResource struct {
+	client *nango.Client
+}
+
+// Metadata returns the resource type name.
+func (r *environmentVariableResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_environment_variable"
+}
+
+// Schema defines the schema for the resource.
+func (r *environmentVariableResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an environment variable that the scripts of the Nango environment can read.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The variable name. Changing this forces a new variable to be created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.StringAttribute{
+				Required:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The variable value",
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *environmentVariableResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan environmentVariableResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	err := r.editVariables(ctx, func(variables []nango.EnvironmentVariable) ([]nango.EnvironmentVariable, error) {
+		for _, variable := range variables {
+			if variable.Name == name {
+				return nil, fmt.Errorf("environment variable %s already exists; import it with `terraform import` to manage it", name)
+			}
+		}
+		return append(variables, nango.EnvironmentVariable{Name: name, Value: plan.Value.ValueString()}), nil
+	})
+	if err != nil {
+		addNangoError(&resp.Diagnostics, "Unable to Create Environment Variable "+name, err, nil)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *environmentVariableResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state environmentVariableResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	variables, err := r.client.ListEnvironmentVariables(ctx)
+	if err != nil {
+		addNangoError(&resp.Diagnostics, "Unable to Read Environment Variables", err, nil)
+		return
+	}
+
+	for _, variable := range variables {
+		if variable.Name == state.Name.ValueString() {
+			state.Value = types.StringValue(variable.Value)
+			diags = resp.State.Set(ctx, &state)
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+	}
+
+	// Deleted outside of Terraform; drop it so the next plan recreates it.
+	resp.State.RemoveResource(ctx)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *environmentVariableResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan environmentVariableResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	err := r.editVariables(ctx, func(variables []nango.EnvironmentVariable) ([]nango.EnvironmentVariable, error) {
+		for i := range variables {
+			if variables[i].Name == name {
+				variables[i].Value = plan.Value.ValueString()
+				return variables, nil
+			}
+		}
+		// Deleted since the last refresh; add it back.
+		return append(variables, nango.EnvironmentVariable{Name: name, Value: plan.Value.ValueString()}), nil
+	})
+	if err != nil {
+		addNangoError(&resp.Diagnostics, "Unable to Update Environment Variable "+name, err, nil)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *environmentVariableResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state environmentVariableResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.Name.ValueString()
+	err := r.editVariables(ctx, func(variables []nango.EnvironmentVariable) ([]nango.EnvironmentVariable, error) {
+		remaining := make([]nango.EnvironmentVariable, 0, len(variables))
+		for _, variable := range variables {
+			if variable.Name != name {
+				remaining = append(remaining, variable)
+			}
+		}
+		return remaining, nil
+	})
+	if err != nil {
+		addNangoError(&resp.Diagnostics, "Unable to Delete Environment Variable "+name, err, nil)
+		return
+	}
+}
+
+// editVariables reads the environment's variables, lets edit change them
+// and writes the result back.
+func (r *environmentVariableResource) editVariables(ctx context.Context, edit func([]nango.EnvironmentVariable) ([]nango.EnvironmentVariable, error)) error {
+	environmentVariablesMu.Lock()
+	defer environmentVariablesMu.Unlock()
+
+	variables, err := r.client.ListEnvironmentVariables(ctx)
+	if err != nil {
+		return err
+	}
+	variables, err = edit(variables)
+	if err != nil {
+		return err
+	}
+	return r.client.SetEnvironmentVariables(ctx, variables)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *environmentVariableResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*nango.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *nango.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ImportState imports the resource into Terraform state by variable name.
+func (r *environmentVariableResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
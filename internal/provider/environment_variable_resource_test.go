@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-nango/internal/nango"
+)
+
+func TestEnvironmentVariableEdits(t *testing.T) {
+	ctx := context.Background()
+	existing := []nango.EnvironmentVariable{{Name: "REGION", Value: "eu"}, {Name: "TIER", Value: "gold"}}
+
+	tests := map[string]struct {
+		operation string
+		name      string
+		value     string
+		want      []nango.EnvironmentVariable
+		wantError bool
+	}{
+		"create": {
+			operation: "create",
+			name:      "DEBUG",
+			value:     "true",
+			want:      []nango.EnvironmentVariable{{Name: "REGION", Value: "eu"}, {Name: "TIER", Value: "gold"}, {Name: "DEBUG", Value: "true"}},
+		},
+		"create duplicate": {
+			operation: "create",
+			name:      "TIER",
+			value:     "silver",
+			wantError: true,
+		},
+		"update": {
+			operation: "update",
+			name:      "TIER",
+			value:     "silver",
+			want:      []nango.EnvironmentVariable{{Name: "REGION", Value: "eu"}, {Name: "TIER", Value: "silver"}},
+		},
+		"update deleted": {
+			operation: "update",
+			name:      "DEBUG",
+			value:     "true",
+			want:      []nango.EnvironmentVariable{{Name: "REGION", Value: "eu"}, {Name: "TIER", Value: "gold"}, {Name: "DEBUG", Value: "true"}},
+		},
+		"delete": {
+			operation: "delete",
+			name:      "REGION",
+			want:      []nango.EnvironmentVariable{{Name: "TIER", Value: "gold"}},
+		},
+		"delete missing": {
+			operation: "delete",
+			name:      "DEBUG",
+			want:      []nango.EnvironmentVariable{{Name: "REGION", Value: "eu"}, {Name: "TIER", Value: "gold"}},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got []nango.EnvironmentVariable
+			r := &environmentVariableResource{client: testNangoClient(t, func(w http.ResponseWriter, req *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch req.Method {
+				case http.MethodGet:
+					_ = json.NewEncoder(w).Encode(existing)
+				case http.MethodPost:
+					var body struct {
+						Variables []nango.EnvironmentVariable `json:"variables"`
+					}
+					if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+						t.Errorf("decoding request body: %v", err)
+					}
+					got = body.Variables
+					_, _ = w.Write([]byte(`{}`))
+				}
+			})}
+
+			var schemaResp resource.SchemaResponse
+			r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+			model := environmentVariableResourceModel{Name: types.StringValue(tt.name), Value: types.StringValue(tt.value)}
+			plan := tfsdk.Plan{Schema: schemaResp.Schema}
+			state := tfsdk.State{Schema: schemaResp.Schema}
+			if diags := plan.Set(ctx, &model); diags.HasError() {
+				t.Fatalf("setting plan: %v", diags)
+			}
+			if diags := state.Set(ctx, &model); diags.HasError() {
+				t.Fatalf("setting state: %v", diags)
+			}
+
+			var diags diag.Diagnostics
+			switch tt.operation {
+			case "create":
+				resp := resource.CreateResponse{State: state}
+				r.Create(ctx, resource.CreateRequest{Plan: plan}, &resp)
+				diags = resp.Diagnostics
+			case "update":
+				resp := resource.UpdateResponse{State: state}
+				r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, &resp)
+				diags = resp.Diagnostics
+			case "delete":
+				resp := resource.DeleteResponse{State: state}
+				r.Delete(ctx, resource.DeleteRequest{State: state}, &resp)
+				diags = resp.Diagnostics
+			}
+
+			if diags.HasError() != tt.wantError {
+				t.Fatalf("HasError = %t, want %t: %v", diags.HasError(), tt.wantError, diags)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("variables written = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"terraform-provider-nango/internal/nango"
+)
+
+// attributePathFunc maps the path of a field in a Nango request body to the
+// Terraform attribute it was built from. It returns false for fields that
+// have no matching attribute.
+type attributePathFunc func(field []string) (path.Path, bool)
+
+// addNangoError appends err to diags. Errors returned by the Nango API get a
+// summary based on their status code and are always reported as a general
+// error with the API's message and the field-level validation errors that
+// attributePath cannot map. Those it can map are attached to the matching
+// attribute in addition.
+func addNangoError(diags *diag.Diagnostics, summary string, err error, attributePath attributePathFunc) {
+	var apiErr *nango.APIError
+	if !errors.As(err, &apiErr) {
+		diags.AddError(summary, err.Error())
+		return
+	}
+
+	summary = fmt.Sprintf("%s: %s", summary, statusSummary(apiErr.StatusCode))
+
+	// The general error leaves out the field errors attached to attributes,
+	// so that each is reported once.
+	unmapped := *apiErr
+	unmapped.Errors = nil
+	for _, fieldErr := range apiErr.Errors {
+		if attributePath != nil {
+			if attrPath, ok := attributePath(fieldErr.Path); ok {
+				diags.AddAttributeError(attrPath, summary, fieldErr.String())
+				continue
+			}
+		}
+		unmapped.Errors = append(unmapped.Errors, fieldErr)
+	}
+
+	detail := fmt.Sprintf("%s\n\n%s %s returned HTTP %d: %s", statusHint(apiErr.StatusCode), apiErr.Method, apiErr.Path, apiErr.StatusCode, unmapped.Detail())
+	diags.AddError(summary, detail)
+}
+
+func statusSummary(statusCode int) string {
+	switch {
+	case statusCode == http.StatusBadRequest, statusCode == http.StatusUnprocessableEntity:
+		return "Invalid Request"
+	case statusCode == http.StatusUnauthorized:
+		return "Unauthorized"
+	case statusCode == http.StatusForbidden:
+		return "Forbidden"
+	case statusCode == http.StatusNotFound:
+		return "Not Found"
+	case statusCode == http.StatusConflict:
+		return "Conflict"
+	case statusCode == http.StatusTooManyRequests:
+		return "Rate Limited"
+	case statusCode >= 500:
+		return "Nango Server Error"
+	default:
+		return "Nango API Error"
+	}
+}
+
+func statusHint(statusCode int) string {
+	switch {
+	case statusCode == http.StatusBadRequest, statusCode == http.StatusUnprocessableEntity:
+		return "Nango rejected the request. Check the configured values against the provider's requirements."
+	case statusCode == http.StatusUnauthorized:
+		return "Nango did not accept the environment key. Check the provider's environment_key or NANGO_ENVIRONMENT_KEY."
+	case statusCode == http.StatusForbidden:
+		return "The environment key is not allowed to perform this operation."
+	case statusCode == http.StatusNotFound:
+		return "The requested object does not exist in this Nango environment."
+	case statusCode == http.StatusConflict:
+		return "The object conflicts with one that already exists. Import it or choose a different key."
+	case statusCode == http.StatusTooManyRequests:
+		return "Nango is rate limiting requests and retries were exhausted. Try again later or reduce parallelism."
+	case statusCode >= 500:
+		return "Nango failed to process the request and retries were exhausted. Try again later."
+	default:
+		return "The Nango API returned an unexpected response."
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"terraform-provider-nango/internal/nango"
+)
+
+// testAttributePath maps only the display_name field.
+func testAttributePath(field []string) (path.Path, bool) {
+	if len(field) == 1 && field[0] == "display_name" {
+		return path.Root("display_name"), true
+	}
+	return path.Empty(), false
+}
+
+func TestAddNangoError(t *testing.T) {
+	displayNameErr := nango.FieldError{Code: "too_big", Message: "Too long", Path: []string{"display_name"}}
+	scopesErr := nango.FieldError{Code: "invalid", Message: "Unknown scope", Path: []string{"credentials", "scopes"}}
+
+	tests := map[string]struct {
+		err              error
+		attributePath    attributePathFunc
+		wantAttributes   []string
+		wantDetail       []string
+		wantDetailAbsent []string
+	}{
+		"not an API error": {
+			err:        errors.New("connection refused"),
+			wantDetail: []string{"connection refused"},
+		},
+		"mapped and unmapped": {
+			err: &nango.APIError{
+				Method: http.MethodPost, Path: "/integrations", StatusCode: http.StatusBadRequest,
+				Code: "invalid_body", Message: "Invalid request",
+				Errors: []nango.FieldError{displayNameErr, scopesErr},
+			},
+			attributePath:    testAttributePath,
+			wantAttributes:   []string{"display_name"},
+			wantDetail:       []string{"HTTP 400", "Invalid request", "credentials.scopes: Unknown scope", "invalid_body", statusHint(http.StatusBadRequest)},
+			wantDetailAbsent: []string{"Too long"},
+		},
+		"all mapped": {
+			err: &nango.APIError{
+				Method: http.MethodPost, Path: "/integrations", StatusCode: http.StatusUnprocessableEntity,
+				Message: "Invalid request",
+				Errors:  []nango.FieldError{displayNameErr},
+			},
+			attributePath:  testAttributePath,
+			wantAttributes: []string{"display_name"},
+			wantDetail:     []string{"HTTP 422", "Invalid request"},
+		},
+		"no attribute paths": {
+			err: &nango.APIError{
+				Method: http.MethodPost, Path: "/integrations", StatusCode: http.StatusBadRequest,
+				Errors: []nango.FieldError{displayNameErr, scopesErr},
+			},
+			wantDetail: []string{"display_name: Too long", "credentials.scopes: Unknown scope"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			addNangoError(&diags, "Unable to Create Integration", tt.err, tt.attributePath)
+
+			var general []diag.Diagnostic
+			var attributes []string
+			for _, d := range diags.Errors() {
+				if withPath, ok := d.(diag.DiagnosticWithPath); ok {
+					attributes = append(attributes, withPath.Path().String())
+					continue
+				}
+				general = append(general, d)
+			}
+
+			if len(general) != 1 {
+				t.Fatalf("got %d general errors, want 1: %v", len(general), diags)
+			}
+			if !strings.HasPrefix(general[0].Summary(), "Unable to Create Integration") {
+				t.Errorf("summary = %q", general[0].Summary())
+			}
+			for _, want := range tt.wantDetail {
+				if !strings.Contains(general[0].Detail(), want) {
+					t.Errorf("detail %q does not contain %q", general[0].Detail(), want)
+				}
+			}
+			for _, absent := range tt.wantDetailAbsent {
+				if strings.Contains(general[0].Detail(), absent) {
+					t.Errorf("detail %q repeats the attribute error %q", general[0].Detail(), absent)
+				}
+			}
+			if strings.Join(attributes, ",") != strings.Join(tt.wantAttributes, ",") {
+				t.Errorf("attribute errors on %v, want %v", attributes, tt.wantAttributes)
+			}
+		})
+	}
+}
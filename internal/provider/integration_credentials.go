@@ -0,0 +1,664 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-nango/internal/nango"
+)
+
+// scopesDelimiter is what the Nango API joins the scopes of an integration
+// with. Scopes are also split on the provider's separator, see
+// scopeSeparator.
+const scopesDelimiter = ","
+
+// defaultScopeSeparator is the scope separator of providers that do not set
+// their own in the Nango catalog.
+const defaultScopeSeparator = " "
+
+// credentialKinds maps each nested object of the credentials attribute to
+// the Nango credential type it configures.
+var credentialKinds = map[string]string{
+	"oauth2": nango.CredentialsOAuth2,
+	"oauth1": nango.CredentialsOAuth1,
+	"tba":    nango.CredentialsTBA,
+	"app":    nango.CredentialsApp,
+	"custom": nango.CredentialsCustom,
+}
+
+// authModesWithoutCredentials lists provider auth modes whose integrations
+// carry no credentials; the end user supplies them per connection.
+var authModesWithoutCredentials = map[string]bool{
+	"API_KEY":   true,
+	"BASIC":     true,
+	"NONE":      true,
+	"JWT":       true,
+	"BILL":      true,
+	"SIGNATURE": true,
+	"TWO_STEP":  true,
+	"OAUTH2_CC": true,
+	"APP_STORE": true,
+}
+
+type credentialsModel struct {
+	OAuth2 *oauthCredentialsModel  `tfsdk:"oauth2"`
+	OAuth1 *oauthCredentialsModel  `tfsdk:"oauth1"`
+	TBA    *oauthCredentialsModel  `tfsdk:"tba"`
+	App    *appCredentialsModel    `tfsdk:"app"`
+	Custom *customCredentialsModel `tfsdk:"custom"`
+}
+
+type oauthCredentialsModel struct {
+	ClientId              types.String `tfsdk:"client_id"`
+	ClientSecret          types.String `tfsdk:"client_secret"`
+	ClientSecretWo        types.String `tfsdk:"client_secret_wo"`
+	ClientSecretWoVersion types.Int64  `tfsdk:"client_secret_wo_version"`
+	Scopes                types.Set    `tfsdk:"scopes"`
+}
+
+type appCredentialsModel struct {
+	AppId      types.String `tfsdk:"app_id"`
+	AppLink    types.String `tfsdk:"app_link"`
+	PrivateKey types.String `tfsdk:"private_key"`
+}
+
+type customCredentialsModel struct {
+	ClientId              types.String `tfsdk:"client_id"`
+	ClientSecret          types.String `tfsdk:"client_secret"`
+	ClientSecretWo        types.String `tfsdk:"client_secret_wo"`
+	ClientSecretWoVersion types.Int64  `tfsdk:"client_secret_wo_version"`
+	AppId                 types.String `tfsdk:"app_id"`
+	AppLink               types.String `tfsdk:"app_link"`
+	PrivateKey            types.String `tfsdk:"private_key"`
+}
+
+func credentialsSchema() schema.SingleNestedAttribute {
+	oauthAttributes := func(name string) schema.SingleNestedAttribute {
+		return schema.SingleNestedAttribute{
+			Optional:            true,
+			MarkdownDescription: name + " credentials.",
+			Attributes: map[string]schema.Attribute{
+				"client_id": schema.StringAttribute{
+					Required:            true,
+					MarkdownDescription: "The client ID",
+				},
+				"client_secret":            clientSecretAttribute(),
+				"client_secret_wo":         clientSecretWoAttribute(),
+				"client_secret_wo_version": clientSecretWoVersionAttribute(),
+				"scopes": schema.SetAttribute{
+					Optional:            true,
+					Computed:            true,
+					MarkdownDescription: "The scopes for this credential. Order and repeated scopes are ignored. Defaults to no scopes.",
+					ElementType:         types.StringType,
+					Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+				},
+			},
+		}
+	}
+
+	return schema.SingleNestedAttribute{
+		Optional:            true,
+		MarkdownDescription: "The credentials for this integration. Set exactly one of `oauth2`, `oauth1`, `tba`, `app` or `custom`, matching the provider's auth mode. Leave unset for providers whose credentials are supplied per connection, such as `API_KEY` or `BASIC`.",
+		Attributes: map[string]schema.Attribute{
+			"oauth2": oauthAttributes("OAuth 2.0"),
+			"oauth1": oauthAttributes("OAuth 1.0a"),
+			"tba":    oauthAttributes("Token-based authentication (TBA)"),
+			"app": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "App credentials, for example a GitHub App.",
+				Attributes: map[string]schema.Attribute{
+					"app_id": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "The app ID",
+					},
+					"app_link": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "The public link to the app",
+					},
+					"private_key": schema.StringAttribute{
+						Required:            true,
+						Sensitive:           true,
+						MarkdownDescription: "The app's private key, PEM encoded",
+					},
+				},
+			},
+			"custom": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Custom credentials combining an OAuth client and an app, for example a GitHub App with user OAuth.",
+				Attributes: map[string]schema.Attribute{
+					"client_id": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "The client ID",
+					},
+					"client_secret":            clientSecretAttribute(),
+					"client_secret_wo":         clientSecretWoAttribute(),
+					"client_secret_wo_version": clientSecretWoVersionAttribute(),
+					"app_id": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "The app ID",
+					},
+					"app_link": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "The public link to the app",
+					},
+					"private_key": schema.StringAttribute{
+						Required:            true,
+						Sensitive:           true,
+						MarkdownDescription: "The app's private key, PEM encoded",
+					},
+				},
+			},
+		},
+	}
+}
+
+// clientSecretAttribute, clientSecretWoAttribute and
+// clientSecretWoVersionAttribute define the two ways of setting a client
+// secret: stored in state, or write-only with a version to trigger updates.
+func clientSecretAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:            true,
+		Sensitive:           true,
+		MarkdownDescription: "The client secret. It is stored in state; use `client_secret_wo` to avoid that. Exactly one of `client_secret` and `client_secret_wo` must be set.",
+	}
+}
+
+func clientSecretWoAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:            true,
+		Sensitive:           true,
+		WriteOnly:           true,
+		MarkdownDescription: "The client secret, write-only. It is sent to Nango but never stored in plan or state, so it can come from an ephemeral resource. Requires Terraform 1.11 or later.",
+	}
+}
+
+func clientSecretWoVersionAttribute() schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Optional:            true,
+		MarkdownDescription: "A version for `client_secret_wo`. Terraform cannot see changes to write-only values, so change this to send a rotated secret to Nango.",
+	}
+}
+
+// kind returns the name of the nested object that is set, or "" if none is.
+func (m *credentialsModel) kind() string {
+	switch {
+	case m == nil:
+		return ""
+	case m.OAuth2 != nil:
+		return "oauth2"
+	case m.OAuth1 != nil:
+		return "oauth1"
+	case m.TBA != nil:
+		return "tba"
+	case m.App != nil:
+		return "app"
+	case m.Custom != nil:
+		return "custom"
+	}
+	return ""
+}
+
+// oauth returns whichever of the OAuth-shaped objects is set.
+func (m *credentialsModel) oauth() *oauthCredentialsModel {
+	switch m.kind() {
+	case "oauth2":
+		return m.OAuth2
+	case "oauth1":
+		return m.OAuth1
+	case "tba":
+		return m.TBA
+	}
+	return nil
+}
+
+// toRequest builds the credentials payload for the Nango API. It returns nil
+// when no credentials are configured.
+func (m *credentialsModel) toRequest(ctx context.Context) (*nango.IntegrationCredentialsRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	kind := m.kind()
+	if kind == "" {
+		return nil, diags
+	}
+	request := &nango.IntegrationCredentialsRequest{Type: credentialKinds[kind]}
+
+	if oauth := m.oauth(); oauth != nil {
+		// Sort the scopes so that the request does not depend on set order.
+		var scopes []string
+		diags.Append(oauth.Scopes.ElementsAs(ctx, &scopes, false)...)
+		sort.Strings(scopes)
+		scopesString := strings.Join(scopes, scopesDelimiter)
+
+		request.ClientID = oauth.ClientId.ValueString()
+		request.ClientSecret = clientSecret(oauth.ClientSecret, oauth.ClientSecretWo)
+		request.Scopes = &scopesString
+	}
+	if m.App != nil {
+		request.AppID = m.App.AppId.ValueString()
+		request.AppLink = m.App.AppLink.ValueString()
+		request.PrivateKey = m.App.PrivateKey.ValueString()
+	}
+	if m.Custom != nil {
+		request.ClientID = m.Custom.ClientId.ValueString()
+		request.ClientSecret = clientSecret(m.Custom.ClientSecret, m.Custom.ClientSecretWo)
+		request.AppID = m.Custom.AppId.ValueString()
+		request.AppLink = m.Custom.AppLink.ValueString()
+		request.PrivateKey = m.Custom.PrivateKey.ValueString()
+	}
+	return request, diags
+}
+
+// clientSecret returns the write-only secret when it is set, and the stored
+// one otherwise.
+func clientSecret(secret, secretWo types.String) string {
+	if !secretWo.IsNull() {
+		return secretWo.ValueString()
+	}
+	return secret.ValueString()
+}
+
+// usesClientSecretWo reports whether the client secret is set through
+// client_secret_wo.
+func (m *credentialsModel) usesClientSecretWo() bool {
+	if oauth := m.oauth(); oauth != nil {
+		return !oauth.ClientSecretWo.IsNull()
+	}
+	if m.kind() == "custom" {
+		return !m.Custom.ClientSecretWo.IsNull()
+	}
+	return false
+}
+
+// copyWriteOnly copies the write-only secrets from config into m. Terraform
+// only provides write-only values in the configuration; they are always null
+// in plan and state.
+func (m *credentialsModel) copyWriteOnly(config *credentialsModel) {
+	if m.kind() == "" || config.kind() != m.kind() {
+		return
+	}
+	if oauth := m.oauth(); oauth != nil {
+		oauth.ClientSecretWo = config.oauth().ClientSecretWo
+	}
+	if m.Custom != nil {
+		m.Custom.ClientSecretWo = config.Custom.ClientSecretWo
+	}
+}
+
+// secretsChanged reports whether m holds new secrets compared to prior for
+// the same kind of credentials: a different client_secret or private_key, or
+// a different client_secret_wo_version.
+func (m *credentialsModel) secretsChanged(prior *credentialsModel) bool {
+	kind := m.kind()
+	if kind == "" || prior.kind() != kind {
+		return false
+	}
+
+	switch kind {
+	case "oauth2", "oauth1", "tba":
+		oauth, priorOAuth := m.oauth(), prior.oauth()
+		return !oauth.ClientSecret.Equal(priorOAuth.ClientSecret) ||
+			!oauth.ClientSecretWoVersion.Equal(priorOAuth.ClientSecretWoVersion)
+	case "app":
+		return !m.App.PrivateKey.Equal(prior.App.PrivateKey)
+	case "custom":
+		return !m.Custom.ClientSecret.Equal(prior.Custom.ClientSecret) ||
+			!m.Custom.ClientSecretWoVersion.Equal(prior.Custom.ClientSecretWoVersion) ||
+			!m.Custom.PrivateKey.Equal(prior.Custom.PrivateKey)
+	}
+	return false
+}
+
+// verifyRotation checks that the secrets Nango returns are the ones that were
+// just sent. Nango does not always return secrets; one that is not returned
+// is taken as applied only when updatedAtChanged reports that Nango recorded
+// a change to the integration. Otherwise the rotation cannot be confirmed and
+// is reported as an error.
+func (m *credentialsModel) verifyRotation(sent *nango.IntegrationCredentialsRequest, api *nango.IntegrationCredentials, updatedAtChanged bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	kindPath := path.Root("credentials").AtName(m.kind())
+	if api == nil {
+		diags.AddAttributeError(kindPath, "Rotation Not Verified", "Nango returned no credentials after the rotation.")
+		return diags
+	}
+
+	clientSecretName := "client_secret"
+	if m.usesClientSecretWo() {
+		clientSecretName = "client_secret_wo"
+	}
+	secrets := []struct {
+		name, sent, got string
+	}{
+		{clientSecretName, sent.ClientSecret, api.ClientSecret},
+		{"private_key", sent.PrivateKey, api.PrivateKey},
+	}
+	for _, secret := range secrets {
+		switch {
+		case secret.sent == "":
+		case secret.got == "":
+			if !updatedAtChanged {
+				diags.AddAttributeError(
+					kindPath.AtName(secret.name),
+					"Rotation Not Verified",
+					"Nango did not return the "+secret.name+" after the rotation and the integration's updated_at did not change, "+
+						"so the rotation could not be confirmed. Check the integration in Nango and apply again to retry.",
+				)
+			}
+		case strings.TrimSpace(secret.got) != strings.TrimSpace(secret.sent):
+			diags.AddAttributeError(
+				kindPath.AtName(secret.name),
+				"Rotation Not Applied",
+				"Nango still returns a different "+secret.name+" after the rotation. Apply again to retry.",
+			)
+		}
+	}
+	return diags
+}
+
+// withoutWriteOnly returns a copy of m with the write-only secrets cleared,
+// for state that is saved before the framework nulls them itself.
+func (m *credentialsModel) withoutWriteOnly() *credentialsModel {
+	if m == nil {
+		return nil
+	}
+
+	credentials := *m
+	for _, oauth := range []**oauthCredentialsModel{&credentials.OAuth2, &credentials.OAuth1, &credentials.TBA} {
+		if *oauth != nil {
+			cleared := **oauth
+			cleared.ClientSecretWo = types.StringNull()
+			*oauth = &cleared
+		}
+	}
+	if credentials.Custom != nil {
+		cleared := *credentials.Custom
+		cleared.ClientSecretWo = types.StringNull()
+		credentials.Custom = &cleared
+	}
+	return &credentials
+}
+
+// refreshCredentials builds the credentials state from what Nango returned.
+// Secrets are only overwritten when the API returns them, so an omitted
+// secret keeps its prior value instead of showing up as a permanent diff.
+// A client_secret that was null stays null: it is then set through
+// client_secret_wo, which must not end up in state.
+// Scopes are split on scopeSeparator, the provider's separator in the Nango
+// catalog, as well as on commas.
+func refreshCredentials(api *nango.IntegrationCredentials, prior *credentialsModel, scopeSeparator string) (*credentialsModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if api == nil {
+		return nil, diags
+	}
+
+	kind := ""
+	for k, credentialType := range credentialKinds {
+		if strings.EqualFold(api.Type, credentialType) {
+			kind = k
+		}
+	}
+	if kind == "" {
+		return nil, diags
+	}
+
+	// Start from the prior object of the same kind so that values Nango does
+	// not return are kept.
+	if prior == nil || prior.kind() != kind {
+		prior = &credentialsModel{}
+	}
+	credentials := &credentialsModel{}
+
+	switch kind {
+	case "oauth2", "oauth1", "tba":
+		oauth := prior.oauth()
+		fresh := oauth == nil
+		if fresh {
+			oauth = &oauthCredentialsModel{
+				ClientSecret:          types.StringNull(),
+				ClientSecretWo:        types.StringNull(),
+				ClientSecretWoVersion: types.Int64Null(),
+			}
+		}
+		oauth.ClientId = types.StringValue(api.ClientID)
+		if api.ClientSecret != "" && (fresh || !oauth.ClientSecret.IsNull()) {
+			oauth.ClientSecret = types.StringValue(api.ClientSecret)
+		}
+
+		// Parse scopes from API response back into a set so Terraform can
+		// detect drift; no scopes is an empty set, matching the default.
+		scopes, scopeDiags := scopesSet(splitScopes(api.Scopes, scopeSeparator))
+		diags.Append(scopeDiags...)
+		oauth.Scopes = scopes
+
+		switch kind {
+		case "oauth2":
+			credentials.OAuth2 = oauth
+		case "oauth1":
+			credentials.OAuth1 = oauth
+		case "tba":
+			credentials.TBA = oauth
+		}
+	case "app":
+		app := prior.App
+		if app == nil {
+			app = &appCredentialsModel{PrivateKey: types.StringNull()}
+		}
+		app.AppId = types.StringValue(api.AppID)
+		app.AppLink = types.StringValue(api.AppLink)
+		if api.PrivateKey != "" {
+			app.PrivateKey = types.StringValue(api.PrivateKey)
+		}
+		credentials.App = app
+	case "custom":
+		custom := prior.Custom
+		fresh := custom == nil
+		if fresh {
+			custom = &customCredentialsModel{
+				ClientSecret:          types.StringNull(),
+				ClientSecretWo:        types.StringNull(),
+				ClientSecretWoVersion: types.Int64Null(),
+				PrivateKey:            types.StringNull(),
+			}
+		}
+		custom.ClientId = types.StringValue(api.ClientID)
+		custom.AppId = types.StringValue(api.AppID)
+		custom.AppLink = types.StringValue(api.AppLink)
+		if api.ClientSecret != "" && (fresh || !custom.ClientSecret.IsNull()) {
+			custom.ClientSecret = types.StringValue(api.ClientSecret)
+		}
+		if api.PrivateKey != "" {
+			custom.PrivateKey = types.StringValue(api.PrivateKey)
+		}
+		credentials.Custom = custom
+	}
+
+	return credentials, diags
+}
+
+// scopeSeparator returns the scope separator of provider in the Nango
+// catalog, or defaultScopeSeparator when the provider is unknown or sets
+// none.
+func scopeSeparator(provider *nango.Provider) string {
+	if provider == nil || provider.ScopeSeparator == "" {
+		return defaultScopeSeparator
+	}
+	return provider.ScopeSeparator
+}
+
+// splitScopes splits the scopes string returned by Nango. Nango joins scopes
+// with commas, but scopes entered with the provider's own separator, such as
+// a space, are split on it too. Empty and repeated scopes are dropped.
+func splitScopes(scopes string, separator string) []string {
+	if separator == "" {
+		separator = scopesDelimiter
+	}
+
+	var scopeStrings []string
+	for _, part := range strings.Split(scopes, scopesDelimiter) {
+		for _, scope := range strings.Split(part, separator) {
+			scope = strings.TrimSpace(scope)
+			if scope != "" && !slices.Contains(scopeStrings, scope) {
+				scopeStrings = append(scopeStrings, scope)
+			}
+		}
+	}
+	return scopeStrings
+}
+
+// scopesSet builds the scopes attribute from a list of scopes, dropping
+// repeated ones.
+func scopesSet(scopes []string) (types.Set, diag.Diagnostics) {
+	values := make([]attr.Value, 0, len(scopes))
+	seen := make(map[string]bool, len(scopes))
+	for _, scope := range scopes {
+		if !seen[scope] {
+			seen[scope] = true
+			values = append(values, types.StringValue(scope))
+		}
+	}
+	return types.SetValue(types.StringType, values)
+}
+
+// secretPath returns the path of the secret that Nango may not return for
+// the configured kind of credentials, along with its current value.
+func (m *credentialsModel) secretPath() (path.Path, types.String, bool) {
+	kind := m.kind()
+	switch kind {
+	case "oauth2", "oauth1", "tba":
+		return path.Root("credentials").AtName(kind).AtName("client_secret"), m.oauth().ClientSecret, true
+	case "app":
+		return path.Root("credentials").AtName(kind).AtName("private_key"), m.App.PrivateKey, true
+	case "custom":
+		return path.Root("credentials").AtName(kind).AtName("client_secret"), m.Custom.ClientSecret, true
+	}
+	return path.Empty(), types.StringNull(), false
+}
+
+// validateCredentialsObject checks that at most one kind of credentials is
+// set in credentials. Unknown objects are skipped, they are validated again
+// once known.
+func validateCredentialsObject(credentials types.Object) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if credentials.IsNull() || credentials.IsUnknown() {
+		return diags
+	}
+
+	var set []string
+	unknown := false
+	for name, value := range credentials.Attributes() {
+		if value.IsUnknown() {
+			unknown = true
+			continue
+		}
+		if !value.IsNull() {
+			set = append(set, name)
+		}
+	}
+	sort.Strings(set)
+
+	switch {
+	case len(set) > 1:
+		diags.AddAttributeError(
+			path.Root("credentials"),
+			"Conflicting Credentials",
+			"Only one of oauth2, oauth1, tba, app or custom may be set, got: "+strings.Join(set, ", ")+".",
+		)
+	case len(set) == 0 && !unknown:
+		diags.AddAttributeError(
+			path.Root("credentials"),
+			"Missing Credentials",
+			"Set exactly one of oauth2, oauth1, tba, app or custom, or remove the credentials attribute for providers without integration credentials.",
+		)
+	}
+	return diags
+}
+
+// validateClientSecrets checks that every configured OAuth client sets
+// exactly one of client_secret and client_secret_wo, and that
+// client_secret_wo_version is only used with client_secret_wo. Unknown values
+// are skipped, they are validated again once known.
+func validateClientSecrets(credentials types.Object) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if credentials.IsNull() || credentials.IsUnknown() {
+		return diags
+	}
+
+	for _, kind := range []string{"oauth2", "oauth1", "tba", "custom"} {
+		object, ok := credentials.Attributes()[kind].(types.Object)
+		if !ok || object.IsNull() || object.IsUnknown() {
+			continue
+		}
+		attributes := object.Attributes()
+		secret, secretWo := attributes["client_secret"], attributes["client_secret_wo"]
+		kindPath := path.Root("credentials").AtName(kind)
+
+		switch {
+		case secret.IsUnknown() || secretWo.IsUnknown():
+		case !secret.IsNull() && !secretWo.IsNull():
+			diags.AddAttributeError(
+				kindPath.AtName("client_secret_wo"),
+				"Conflicting Client Secrets",
+				"Only one of client_secret and client_secret_wo may be set.",
+			)
+		case secret.IsNull() && secretWo.IsNull():
+			diags.AddAttributeError(
+				kindPath.AtName("client_secret"),
+				"Missing Client Secret",
+				"Set either client_secret or client_secret_wo.",
+			)
+		}
+		if version := attributes["client_secret_wo_version"]; !version.IsNull() && secretWo.IsNull() {
+			diags.AddAttributeError(
+				kindPath.AtName("client_secret_wo_version"),
+				"Missing Write-Only Client Secret",
+				"client_secret_wo_version only applies together with client_secret_wo.",
+			)
+		}
+	}
+	return diags
+}
+
+// validateAuthMode checks that the configured credentials fit the auth mode
+// of the provider in the Nango catalog.
+func validateAuthMode(provider *nango.Provider, credentials *credentialsModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	kind := credentials.kind()
+	if authModesWithoutCredentials[provider.AuthMode] {
+		if kind != "" {
+			diags.AddAttributeError(
+				path.Root("credentials"),
+				"Credentials Not Supported",
+				"Provider "+provider.Name+" uses the "+provider.AuthMode+" auth mode, which has no integration credentials. Remove the credentials attribute.",
+			)
+		}
+		return diags
+	}
+
+	for expected, credentialType := range credentialKinds {
+		if credentialType != provider.AuthMode || expected == kind {
+			continue
+		}
+		diags.AddAttributeError(
+			path.Root("credentials"),
+			"Credentials Do Not Match Auth Mode",
+			"Provider "+provider.Name+" uses the "+provider.AuthMode+" auth mode. Configure credentials."+expected+" instead.",
+		)
+	}
+	return diags
+}
@@ -0,0 +1,449 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-nango/internal/nango"
+)
+
+// credentialsObject builds a value of the credentials attribute with the
+// given nested objects set and every other kind null.
+func credentialsObject(t *testing.T, set map[string]any) types.Object {
+	t.Helper()
+
+	objectType := credentialsSchema().GetType().(types.ObjectType)
+	attributes := make(map[string]attr.Value, len(objectType.AttrTypes))
+	for name, attrType := range objectType.AttrTypes {
+		kindTypes := attrType.(types.ObjectType).AttrTypes
+		switch value := set[name].(type) {
+		case nil:
+			attributes[name] = types.ObjectNull(kindTypes)
+		case attr.Value:
+			attributes[name] = value
+		default:
+			object, diags := types.ObjectValueFrom(context.Background(), kindTypes, value)
+			if diags.HasError() {
+				t.Fatalf("building %s: %v", name, diags)
+			}
+			attributes[name] = object
+		}
+	}
+	return types.ObjectValueMust(objectType.AttrTypes, attributes)
+}
+
+func testOAuthCredentials(secret string) oauthCredentialsModel {
+	return oauthCredentialsModel{
+		ClientId:              types.StringValue("client"),
+		ClientSecret:          types.StringValue(secret),
+		ClientSecretWo:        types.StringNull(),
+		ClientSecretWoVersion: types.Int64Null(),
+		Scopes:                types.SetValueMust(types.StringType, []attr.Value{}),
+	}
+}
+
+func TestValidateCredentialsObject(t *testing.T) {
+	oauth := testOAuthCredentials("secret")
+	app := appCredentialsModel{
+		AppId:      types.StringValue("1"),
+		AppLink:    types.StringValue("https://github.com/apps/test"),
+		PrivateKey: types.StringValue("key"),
+	}
+	objectType := credentialsSchema().GetType().(types.ObjectType)
+
+	tests := map[string]struct {
+		credentials types.Object
+		wantError   bool
+	}{
+		"null":      {credentials: types.ObjectNull(objectType.AttrTypes)},
+		"unknown":   {credentials: types.ObjectUnknown(objectType.AttrTypes)},
+		"one kind":  {credentials: credentialsObject(t, map[string]any{"oauth2": oauth})},
+		"two kinds": {credentials: credentialsObject(t, map[string]any{"oauth2": oauth, "app": app}), wantError: true},
+		"no kind":   {credentials: credentialsObject(t, nil), wantError: true},
+		"unknown kind": {credentials: credentialsObject(t, map[string]any{
+			"oauth1": types.ObjectUnknown(objectType.AttrTypes["oauth1"].(types.ObjectType).AttrTypes),
+		})},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			diags := validateCredentialsObject(tt.credentials)
+			if diags.HasError() != tt.wantError {
+				t.Errorf("HasError() = %t, want %t: %v", diags.HasError(), tt.wantError, diags)
+			}
+		})
+	}
+}
+
+func TestValidateAuthMode(t *testing.T) {
+	oauth := testOAuthCredentials("secret")
+	app := &appCredentialsModel{}
+
+	tests := map[string]struct {
+		authMode    string
+		credentials *credentialsModel
+		wantError   bool
+	}{
+		"matching oauth2":          {authMode: "OAUTH2", credentials: &credentialsModel{OAuth2: &oauth}},
+		"matching app":             {authMode: "APP", credentials: &credentialsModel{App: app}},
+		"wrong kind":               {authMode: "OAUTH2", credentials: &credentialsModel{App: app}, wantError: true},
+		"missing credentials":      {authMode: "OAUTH1", wantError: true},
+		"no credentials needed":    {authMode: "API_KEY"},
+		"credentials not accepted": {authMode: "API_KEY", credentials: &credentialsModel{OAuth2: &oauth}, wantError: true},
+		"unknown auth mode":        {authMode: "SOMETHING_NEW", credentials: &credentialsModel{OAuth2: &oauth}},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			diags := validateAuthMode(&nango.Provider{Name: "test", AuthMode: tt.authMode}, tt.credentials)
+			if diags.HasError() != tt.wantError {
+				t.Errorf("HasError() = %t, want %t: %v", diags.HasError(), tt.wantError, diags)
+			}
+		})
+	}
+}
+
+func TestRefreshCredentials(t *testing.T) {
+	t.Run("no credentials", func(t *testing.T) {
+		credentials, diags := refreshCredentials(nil, &credentialsModel{}, ",")
+		if diags.HasError() || credentials != nil {
+			t.Errorf("got %+v, %v; want nil", credentials, diags)
+		}
+	})
+
+	t.Run("unknown type", func(t *testing.T) {
+		credentials, diags := refreshCredentials(&nango.IntegrationCredentials{Type: "SOMETHING_NEW"}, nil, ",")
+		if diags.HasError() || credentials != nil {
+			t.Errorf("got %+v, %v; want nil", credentials, diags)
+		}
+	})
+
+	t.Run("imported oauth2", func(t *testing.T) {
+		credentials, diags := refreshCredentials(&nango.IntegrationCredentials{
+			Type:         nango.CredentialsOAuth2,
+			ClientID:     "client",
+			ClientSecret: "secret",
+			Scopes:       "email,profile",
+		}, nil, " ")
+		if diags.HasError() {
+			t.Fatalf("refreshCredentials: %v", diags)
+		}
+		if credentials.kind() != "oauth2" {
+			t.Fatalf("kind = %q, want oauth2", credentials.kind())
+		}
+		if got := credentials.OAuth2.ClientSecret.ValueString(); got != "secret" {
+			t.Errorf("client_secret = %q, want secret", got)
+		}
+		if got := len(credentials.OAuth2.Scopes.Elements()); got != 2 {
+			t.Errorf("got %d scopes, want 2", got)
+		}
+	})
+
+	t.Run("omitted secret is kept", func(t *testing.T) {
+		oauth := testOAuthCredentials("secret")
+		credentials, diags := refreshCredentials(&nango.IntegrationCredentials{
+			Type:     "oauth1",
+			ClientID: "new-client",
+		}, &credentialsModel{OAuth1: &oauth}, ",")
+		if diags.HasError() {
+			t.Fatalf("refreshCredentials: %v", diags)
+		}
+		if got := credentials.OAuth1.ClientSecret.ValueString(); got != "secret" {
+			t.Errorf("client_secret = %q, want the prior secret", got)
+		}
+		if got := credentials.OAuth1.ClientId.ValueString(); got != "new-client" {
+			t.Errorf("client_id = %q, want new-client", got)
+		}
+	})
+
+	t.Run("write-only secret stays out of state", func(t *testing.T) {
+		oauth := testOAuthCredentials("")
+		oauth.ClientSecret = types.StringNull()
+		credentials, diags := refreshCredentials(&nango.IntegrationCredentials{
+			Type:         nango.CredentialsOAuth2,
+			ClientID:     "client",
+			ClientSecret: "secret",
+		}, &credentialsModel{OAuth2: &oauth}, ",")
+		if diags.HasError() {
+			t.Fatalf("refreshCredentials: %v", diags)
+		}
+		if !credentials.OAuth2.ClientSecret.IsNull() {
+			t.Errorf("client_secret = %s, want null", credentials.OAuth2.ClientSecret)
+		}
+	})
+
+	t.Run("kind changed", func(t *testing.T) {
+		oauth := testOAuthCredentials("secret")
+		credentials, diags := refreshCredentials(&nango.IntegrationCredentials{
+			Type:    nango.CredentialsApp,
+			AppID:   "1",
+			AppLink: "https://github.com/apps/test",
+		}, &credentialsModel{OAuth2: &oauth}, ",")
+		if diags.HasError() {
+			t.Fatalf("refreshCredentials: %v", diags)
+		}
+		if credentials.kind() != "app" {
+			t.Fatalf("kind = %q, want app", credentials.kind())
+		}
+		if !credentials.App.PrivateKey.IsNull() || credentials.App.AppId.ValueString() != "1" {
+			t.Errorf("app = %+v, want app_id 1 and a null private_key", credentials.App)
+		}
+	})
+
+	t.Run("custom keeps private key", func(t *testing.T) {
+		prior := &credentialsModel{Custom: &customCredentialsModel{
+			ClientSecret: types.StringValue("secret"),
+			PrivateKey:   types.StringValue("key"),
+		}}
+		credentials, diags := refreshCredentials(&nango.IntegrationCredentials{
+			Type:         nango.CredentialsCustom,
+			ClientID:     "client",
+			ClientSecret: "rotated",
+			AppID:        "1",
+			AppLink:      "https://github.com/apps/test",
+		}, prior, ",")
+		if diags.HasError() {
+			t.Fatalf("refreshCredentials: %v", diags)
+		}
+		if got := credentials.Custom.PrivateKey.ValueString(); got != "key" {
+			t.Errorf("private_key = %q, want the prior key", got)
+		}
+		if got := credentials.Custom.ClientSecret.ValueString(); got != "rotated" {
+			t.Errorf("client_secret = %q, want rotated", got)
+		}
+	})
+}
+
+func TestSecretsChanged(t *testing.T) {
+	oauth := testOAuthCredentials("secret")
+	rotated := testOAuthCredentials("rotated")
+	writeOnly := testOAuthCredentials("")
+	writeOnly.ClientSecret = types.StringNull()
+	writeOnly.ClientSecretWoVersion = types.Int64Value(1)
+	writeOnlyNext := writeOnly
+	writeOnlyNext.ClientSecretWoVersion = types.Int64Value(2)
+	renamed := testOAuthCredentials("secret")
+	renamed.ClientId = types.StringValue("other-client")
+
+	tests := map[string]struct {
+		planned, prior *credentialsModel
+		want           bool
+	}{
+		"unchanged":            {planned: &credentialsModel{OAuth2: &oauth}, prior: &credentialsModel{OAuth2: &oauth}},
+		"new client_secret":    {planned: &credentialsModel{OAuth2: &rotated}, prior: &credentialsModel{OAuth2: &oauth}, want: true},
+		"new wo version":       {planned: &credentialsModel{TBA: &writeOnlyNext}, prior: &credentialsModel{TBA: &writeOnly}, want: true},
+		"only client_id":       {planned: &credentialsModel{OAuth2: &renamed}, prior: &credentialsModel{OAuth2: &oauth}},
+		"different kind":       {planned: &credentialsModel{OAuth1: &rotated}, prior: &credentialsModel{OAuth2: &oauth}},
+		"no prior":             {planned: &credentialsModel{OAuth2: &rotated}},
+		"no planned":           {prior: &credentialsModel{OAuth2: &oauth}},
+		"new app key":          {planned: &credentialsModel{App: &appCredentialsModel{PrivateKey: types.StringValue("new")}}, prior: &credentialsModel{App: &appCredentialsModel{PrivateKey: types.StringValue("old")}}, want: true},
+		"new custom key":       {planned: &credentialsModel{Custom: &customCredentialsModel{PrivateKey: types.StringValue("new")}}, prior: &credentialsModel{Custom: &customCredentialsModel{PrivateKey: types.StringValue("old")}}, want: true},
+		"unchanged app":        {planned: &credentialsModel{App: &appCredentialsModel{PrivateKey: types.StringValue("key")}}, prior: &credentialsModel{App: &appCredentialsModel{PrivateKey: types.StringValue("key")}}},
+		"custom wo version":    {planned: &credentialsModel{Custom: &customCredentialsModel{ClientSecretWoVersion: types.Int64Value(2)}}, prior: &credentialsModel{Custom: &customCredentialsModel{ClientSecretWoVersion: types.Int64Value(1)}}, want: true},
+		"custom client_id":     {planned: &credentialsModel{Custom: &customCredentialsModel{ClientId: types.StringValue("a")}}, prior: &credentialsModel{Custom: &customCredentialsModel{ClientId: types.StringValue("b")}}},
+		"secret to write-only": {planned: &credentialsModel{OAuth2: &writeOnly}, prior: &credentialsModel{OAuth2: &oauth}, want: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := tt.planned.secretsChanged(tt.prior); got != tt.want {
+				t.Errorf("secretsChanged() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVerifyRotation(t *testing.T) {
+	oauth := testOAuthCredentials("rotated")
+	writeOnly := testOAuthCredentials("")
+	writeOnly.ClientSecret = types.StringNull()
+	writeOnly.ClientSecretWo = types.StringValue("rotated")
+
+	tests := map[string]struct {
+		credentials      *credentialsModel
+		sent             nango.IntegrationCredentialsRequest
+		api              *nango.IntegrationCredentials
+		updatedAtChanged bool
+		wantError        bool
+		wantPath         string
+	}{
+		"secret returned": {
+			credentials: &credentialsModel{OAuth2: &oauth},
+			sent:        nango.IntegrationCredentialsRequest{ClientSecret: "rotated"},
+			api:         &nango.IntegrationCredentials{ClientSecret: " rotated\n"},
+		},
+		"old secret returned": {
+			credentials:      &credentialsModel{OAuth2: &oauth},
+			sent:             nango.IntegrationCredentialsRequest{ClientSecret: "rotated"},
+			api:              &nango.IntegrationCredentials{ClientSecret: "secret"},
+			updatedAtChanged: true,
+			wantError:        true,
+			wantPath:         "credentials.oauth2.client_secret",
+		},
+		"secret omitted, updated_at changed": {
+			credentials:      &credentialsModel{OAuth2: &oauth},
+			sent:             nango.IntegrationCredentialsRequest{ClientSecret: "rotated"},
+			api:              &nango.IntegrationCredentials{},
+			updatedAtChanged: true,
+		},
+		"secret omitted, updated_at unchanged": {
+			credentials: &credentialsModel{OAuth2: &oauth},
+			sent:        nango.IntegrationCredentialsRequest{ClientSecret: "rotated"},
+			api:         &nango.IntegrationCredentials{},
+			wantError:   true,
+			wantPath:    "credentials.oauth2.client_secret",
+		},
+		"write-only secret omitted": {
+			credentials: &credentialsModel{OAuth1: &writeOnly},
+			sent:        nango.IntegrationCredentialsRequest{ClientSecret: "rotated"},
+			api:         &nango.IntegrationCredentials{},
+			wantError:   true,
+			wantPath:    "credentials.oauth1.client_secret_wo",
+		},
+		"private key returned": {
+			credentials: &credentialsModel{App: &appCredentialsModel{PrivateKey: types.StringValue("key")}},
+			sent:        nango.IntegrationCredentialsRequest{PrivateKey: "key"},
+			api:         &nango.IntegrationCredentials{PrivateKey: "key"},
+		},
+		"no credentials returned": {
+			credentials:      &credentialsModel{OAuth2: &oauth},
+			sent:             nango.IntegrationCredentialsRequest{ClientSecret: "rotated"},
+			updatedAtChanged: true,
+			wantError:        true,
+			wantPath:         "credentials.oauth2",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			diags := tt.credentials.verifyRotation(&tt.sent, tt.api, tt.updatedAtChanged)
+			if diags.HasError() != tt.wantError {
+				t.Fatalf("HasError() = %t, want %t: %v", diags.HasError(), tt.wantError, diags)
+			}
+			if tt.wantError {
+				withPath, ok := diags.Errors()[0].(diag.DiagnosticWithPath)
+				if !ok || withPath.Path().String() != tt.wantPath {
+					t.Errorf("error %v, want it on %s", diags.Errors()[0], tt.wantPath)
+				}
+			}
+		})
+	}
+}
+
+func TestWithoutWriteOnly(t *testing.T) {
+	writeOnly := testOAuthCredentials("")
+	writeOnly.ClientSecretWo = types.StringValue("secret")
+	custom := customCredentialsModel{ClientSecretWo: types.StringValue("secret")}
+	credentials := &credentialsModel{OAuth2: &writeOnly, Custom: &custom}
+
+	cleared := credentials.withoutWriteOnly()
+	if !cleared.OAuth2.ClientSecretWo.IsNull() || !cleared.Custom.ClientSecretWo.IsNull() {
+		t.Errorf("got %+v, want the write-only secrets null", cleared)
+	}
+	if writeOnly.ClientSecretWo.ValueString() != "secret" || custom.ClientSecretWo.ValueString() != "secret" {
+		t.Error("withoutWriteOnly modified the original credentials")
+	}
+	if (*credentialsModel)(nil).withoutWriteOnly() != nil {
+		t.Error("withoutWriteOnly() of nil credentials is not nil")
+	}
+}
+
+func TestSplitScopes(t *testing.T) {
+	tests := map[string]struct {
+		scopes    string
+		separator string
+		want      []string
+	}{
+		"empty":                 {scopes: "", separator: ",", want: nil},
+		"commas":                {scopes: "email,profile", separator: ",", want: []string{"email", "profile"}},
+		"provider separator":    {scopes: "email profile", separator: " ", want: []string{"email", "profile"}},
+		"both":                  {scopes: "email profile,openid", separator: " ", want: []string{"email", "profile", "openid"}},
+		"no separator":          {scopes: "email,profile", separator: "", want: []string{"email", "profile"}},
+		"other separator kept":  {scopes: "read:user repo", separator: ",", want: []string{"read:user repo"}},
+		"whitespace and blanks": {scopes: " email , ,profile ,", separator: ",", want: []string{"email", "profile"}},
+		"duplicates":            {scopes: "repo,user,repo", separator: ",", want: []string{"repo", "user"}},
+		"plus separator":        {scopes: "files.read+files.write", separator: "+", want: []string{"files.read", "files.write"}},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := splitScopes(tt.scopes, tt.separator)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("splitScopes(%q, %q) = %q, want %q", tt.scopes, tt.separator, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScopesSet(t *testing.T) {
+	tests := map[string]struct {
+		scopes []string
+		want   []string
+	}{
+		"nil":        {want: []string{}},
+		"scopes":     {scopes: []string{"email", "profile"}, want: []string{"email", "profile"}},
+		"duplicates": {scopes: []string{"repo", "repo", "user"}, want: []string{"repo", "user"}},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			set, diags := scopesSet(tt.scopes)
+			if diags.HasError() {
+				t.Fatalf("scopesSet: %v", diags)
+			}
+			if set.IsNull() || set.IsUnknown() {
+				t.Fatalf("got %s, want a known set", set)
+			}
+
+			var got []string
+			if diags := set.ElementsAs(context.Background(), &got, false); diags.HasError() {
+				t.Fatalf("reading set: %v", diags)
+			}
+			slices.Sort(got)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("scopesSet(%q) = %q, want %q", tt.scopes, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScopeSeparator(t *testing.T) {
+	tests := map[string]struct {
+		provider *nango.Provider
+		want     string
+	}{
+		"unknown provider":  {want: defaultScopeSeparator},
+		"no own separator":  {provider: &nango.Provider{Name: "github"}, want: defaultScopeSeparator},
+		"its own separator": {provider: &nango.Provider{Name: "google", ScopeSeparator: " "}, want: " "},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := scopeSeparator(tt.provider); got != tt.want {
+				t.Errorf("scopeSeparator() = %q, want %q", got, tt.want)
+			}
+			// The nango_provider data source reports the same separator.
+			if tt.provider != nil {
+				if got := newProviderModel(tt.provider).ScopeSeparator.ValueString(); got != tt.want {
+					t.Errorf("nango_provider scope_separator = %q, want %q", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestSplitScopesWithoutProviderSeparator(t *testing.T) {
+	// Space separated scopes of a provider without its own separator.
+	got := splitScopes("email profile,openid", scopeSeparator(&nango.Provider{Name: "github"}))
+	if want := []string{"email", "profile", "openid"}; !slices.Equal(got, want) {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
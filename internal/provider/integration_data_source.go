@@ -5,120 +5,125 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-nango/internal/nango"
 )
 
-// Ensure the implementation satisfies the expected integrations.
+// Ensure the implementation satisfies the expected interfaces.
 var (
 	_ datasource.DataSource              = &integrationDataSource{}
 	_ datasource.DataSourceWithConfigure = &integrationDataSource{}
 )
 
 type integrationDataSource struct {
-	client *nangoClient
-}
-
-type nangoIntegrationResponse struct {
-	Data []nangoIntegrationModel `json:"data"`
-}
-
-type nanogoIntegrationResponse2 struct {
-	Data nangoIntegrationModel `json:"data"`
-}
-
-type nangoCredentialsResponseModel struct {
-	Type   string `json:"type"`
-	Scopes string `json:"scopes"`
-}
-
-type nangoIntegrationModel struct {
-	UniqueKey     string                         `json:"unique_key"`
-	DisplayName   string                         `json:"display_name"`
-	NangoProvider string                         `json:"provider"`
-	UpdatedAt     string                         `json:"updated_at"`
-	Credentials   *nangoCredentialsResponseModel `json:"credentials,omitempty"`
+	client *nango.Client
 }
 
-type integrationDataSourceModel struct {
-	Integrations []integrationModel `tfsdk:"integrations"`
-}
-type integrationModel struct {
-	UniqueKey     types.String                `tfsdk:"unique_key"`
-	DisplayName   types.String                `tfsdk:"display_name"`
-	NangoProvider types.String                `tfsdk:"nango_provider"`
-	UpdatedAt     types.String                `tfsdk:"updated_at"`
-	Credentials   *integrationCredentialModel `tfsdk:"credentials"`
+type integrationLookupModel struct {
+	UniqueKey       types.String                    `tfsdk:"unique_key"`
+	DisplayName     types.String                    `tfsdk:"display_name"`
+	NangoProvider   types.String                    `tfsdk:"nango_provider"`
+	AuthMode        types.String                    `tfsdk:"auth_mode"`
+	Logo            types.String                    `tfsdk:"logo"`
+	WebhookURL      types.String                    `tfsdk:"webhook_url"`
+	ForwardWebhooks types.Bool                      `tfsdk:"forward_webhooks"`
+	CreatedAt       types.String                    `tfsdk:"created_at"`
+	UpdatedAt       types.String                    `tfsdk:"updated_at"`
+	Credentials     *integrationCredentialsMetadata `tfsdk:"credentials"`
 }
 
-type integrationCredentialModel struct {
-	ClientId     types.String `tfsdk:"client_id"`
-	ClientSecret types.String `tfsdk:"client_secret"`
-	Type         types.String `tfsdk:"type"`
-	Scopes       types.List   `tfsdk:"scopes"`
+// integrationCredentialsMetadata holds the non-secret part of an
+// integration's credentials.
+type integrationCredentialsMetadata struct {
+	Type     types.String `tfsdk:"type"`
+	ClientId types.String `tfsdk:"client_id"`
+	Scopes   types.List   `tfsdk:"scopes"`
+	AppId    types.String `tfsdk:"app_id"`
+	AppLink  types.String `tfsdk:"app_link"`
 }
 
-// NewCoffeesDataSource is a helper function to simplify the provider implementation.
+// NewIntegrationDataSource is a helper function to simplify the provider implementation.
 func NewIntegrationDataSource() datasource.DataSource {
 	return &integrationDataSource{}
 }
 
 // Metadata returns the data source type name.
 func (d *integrationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_integrations"
+	resp.TypeName = req.ProviderTypeName + "_integration"
 }
 
 // Schema defines the schema for the data source.
 func (d *integrationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up a single Nango integration by its unique key.",
 		Attributes: map[string]schema.Attribute{
-			"integrations": schema.ListNestedAttribute{
-				Computed: true,
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"unique_key": schema.StringAttribute{
-							Computed:            true,
-							MarkdownDescription: "The integration ID that you created in Nango.",
-						},
-						"display_name": schema.StringAttribute{
-							Computed:            true,
-							MarkdownDescription: "The provider display name.",
-						},
-						"nango_provider": schema.StringAttribute{
-							Computed:            true,
-							MarkdownDescription: "The nango_provider",
-						},
-						"updated_at": schema.StringAttribute{
-							Computed:            true,
-							MarkdownDescription: "Last time it was updated",
-						},
-						"credentials": schema.SingleNestedAttribute{
-							Computed:            true,
-							MarkdownDescription: "The credentials for this integration",
-							Attributes: map[string]schema.Attribute{
-								"client_id": schema.StringAttribute{
-									Computed:            true,
-									MarkdownDescription: "The client ID",
-								},
-								"client_secret": schema.StringAttribute{
-									Computed:            true,
-									MarkdownDescription: "The client secret",
-								},
-								"type": schema.StringAttribute{
-									Computed:            true,
-									MarkdownDescription: "The type of credential",
-								},
-								"scopes": schema.ListAttribute{
-									Computed:            true,
-									MarkdownDescription: "The scopes for this credential",
-									ElementType:         types.StringType,
-								},
-							},
-						},
+			"unique_key": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The integration ID that you created in Nango.",
+			},
+			"display_name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The provider display name.",
+			},
+			"nango_provider": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The nango_provider",
+			},
+			"auth_mode": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The provider's auth mode, for example `OAUTH2` or `API_KEY`. Null when the provider catalog cannot be read.",
+			},
+			"logo": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "URL of the provider logo",
+			},
+			"webhook_url": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The URL to register with the provider so that its webhooks reach Nango.",
+			},
+			"forward_webhooks": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether Nango forwards webhooks received from the provider to the environment's webhook URLs.",
+			},
+			"created_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "When the integration was created",
+			},
+			"updated_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Last time it was updated",
+			},
+			"credentials": schema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The non-secret part of the integration's credentials. Null for providers without integration credentials.",
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The type of credential",
+					},
+					"client_id": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The client ID",
+					},
+					"scopes": schema.ListAttribute{
+						Computed:            true,
+						MarkdownDescription: "The scopes for this credential",
+						ElementType:         types.StringType,
+					},
+					"app_id": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The app ID",
+					},
+					"app_link": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The public link to the app",
 					},
 				},
 			},
@@ -128,45 +133,88 @@ func (d *integrationDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 
 // Read refreshes the Terraform state with the latest data.
 func (d *integrationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var state integrationDataSourceModel
+	var state integrationLookupModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	integrationsResponse, err := d.client.client.Get(d.client.baseURL + "/integrations")
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable to Read Integrations",
-			err.Error(),
+	uniqueKey := state.UniqueKey.ValueString()
+	integration, err := d.client.GetIntegration(ctx, uniqueKey, nango.IncludeWebhook, nango.IncludeCredentials)
+	if nango.IsNotFound(err) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("unique_key"),
+			"Integration Not Found",
+			"No integration with unique_key "+uniqueKey+" exists in this Nango environment.",
 		)
 		return
 	}
-
-	//unmarshal response body to integrationDataSourceModel
-	var integrations nangoIntegrationResponse
-	err = json.NewDecoder(integrationsResponse.Body).Decode(&integrations)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable to Decode JSON",
-			err.Error(),
-		)
+		addNangoError(&resp.Diagnostics, "Unable to Read Integration "+uniqueKey, err, nil)
 		return
 	}
 
-	// Set state
-	for _, integration := range integrations.Data {
-		integ := integrationModel{
-			UniqueKey:     types.StringValue(integration.UniqueKey),
-			DisplayName:   types.StringValue(integration.DisplayName),
-			NangoProvider: types.StringValue(integration.NangoProvider),
-			UpdatedAt:     types.StringValue(integration.UpdatedAt),
-			Credentials:   nil, // Set to nil when credentials are not available
-		}
-		state.Integrations = append(state.Integrations, integ)
+	state.UniqueKey = types.StringValue(integration.UniqueKey)
+	state.DisplayName = types.StringValue(integration.DisplayName)
+	state.NangoProvider = types.StringValue(integration.Provider)
+	state.Logo = types.StringValue(integration.Logo)
+	state.WebhookURL = types.StringValue(integration.WebhookURL)
+	state.ForwardWebhooks = types.BoolPointerValue(integration.ForwardWebhooks)
+	state.CreatedAt = types.StringValue(integration.CreatedAt)
+	state.UpdatedAt = types.StringValue(integration.UpdatedAt)
+
+	// The auth mode and scope separator belong to the provider, so look
+	// them up in the catalog. Leave auth_mode null and split scopes on commas
+	// alone rather than failing the lookup when the catalog cannot be read.
+	state.AuthMode = types.StringNull()
+	provider, err := d.client.GetProvider(ctx, integration.Provider)
+	if err != nil {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("auth_mode"),
+			"Unable to Read Provider "+integration.Provider,
+			"auth_mode is left null because the Nango provider catalog could not be read: "+err.Error(),
+		)
+	} else {
+		state.AuthMode = types.StringValue(provider.AuthMode)
 	}
-	fmt.Println(state.Integrations)
-	diags := resp.State.Set(ctx, &state)
+
+	var diags diag.Diagnostics
+	state.Credentials, diags = credentialsMetadata(ctx, integration.Credentials, scopeSeparator(provider))
 	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// credentialsMetadata copies the non-secret credential fields returned by
+// Nango, splitting scopes as splitScopes does. It returns nil when the
+// integration has no credentials.
+func credentialsMetadata(ctx context.Context, credentials *nango.IntegrationCredentials, separator string) (*integrationCredentialsMetadata, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if credentials == nil {
+		return nil, diags
+	}
+
+	metadata := &integrationCredentialsMetadata{
+		Type:     types.StringValue(credentials.Type),
+		ClientId: optionalString(credentials.ClientID),
+		AppId:    optionalString(credentials.AppID),
+		AppLink:  optionalString(credentials.AppLink),
+		Scopes:   types.ListNull(types.StringType),
+	}
+
+	if credentials.Scopes != "" {
+		metadata.Scopes, diags = types.ListValueFrom(ctx, types.StringType, splitScopes(credentials.Scopes, separator))
+	}
+	return metadata, diags
+}
+
+// optionalString returns a null string for empty API values.
+func optionalString(value string) types.String {
+	if value == "" {
+		return types.StringNull()
 	}
+	return types.StringValue(value)
 }
 
 // Configure adds the provider configured client to the data source.
@@ -177,11 +225,11 @@ func (d *integrationDataSource) Configure(_ context.Context, req datasource.Conf
 		return
 	}
 
-	client, ok := req.ProviderData.(*nangoClient)
+	client, ok := req.ProviderData.(*nango.Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *nangoClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *nango.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -5,47 +5,58 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
 
-	"github.com/hashicorp/go-retryablehttp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-nango/internal/nango"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &integrationResource{}
-	_ resource.ResourceWithConfigure = &integrationResource{}
+	_ resource.Resource                   = &integrationResource{}
+	_ resource.ResourceWithConfigure      = &integrationResource{}
+	_ resource.ResourceWithImportState    = &integrationResource{}
+	_ resource.ResourceWithModifyPlan     = &integrationResource{}
+	_ resource.ResourceWithValidateConfig = &integrationResource{}
+	_ resource.ResourceWithUpgradeState   = &integrationResource{}
 )
 
-// NewOrderResource is a helper function to simplify the provider implementation.
+// NewIntegrationResource is a helper function to simplify the provider implementation.
 func NewIntegrationResource() resource.Resource {
 	return &integrationResource{}
 }
 
-type integrationRequestModel struct {
-	UniqueKey     *string                            `json:"unique_key,omitempty"`
-	DisplayName   string                             `json:"display_name"`
-	NangoProvider *string                            `json:"provider,omitempty"`
-	Credentials   integrationCredentialsRequestModel `json:"credentials"`
-}
-
-type integrationCredentialsRequestModel struct {
-	ClientId     string `json:"client_id"`
-	ClientSecret string `json:"client_secret"`
-	Type         string `json:"type"`
-	Scopes       string `json:"scopes"` // Changed to string for API
+// integrationResourceModel maps the nango_integration resource schema data.
+type integrationResourceModel struct {
+	UniqueKey                     types.String      `tfsdk:"unique_key"`
+	DisplayName                   types.String      `tfsdk:"display_name"`
+	NangoProvider                 types.String      `tfsdk:"nango_provider"`
+	UpdatedAt                     types.String      `tfsdk:"updated_at"`
+	Credentials                   *credentialsModel `tfsdk:"credentials"`
+	WebhookURL                    types.String      `tfsdk:"webhook_url"`
+	WebhookSecret                 types.String      `tfsdk:"webhook_secret"`
+	ForwardWebhooks               types.Bool        `tfsdk:"forward_webhooks"`
+	AllowRename                   types.Bool        `tfsdk:"allow_rename"`
+	PreventDestroyWithConnections types.Bool        `tfsdk:"prevent_destroy_with_connections"`
+	RotationMode                  types.Bool        `tfsdk:"rotation_mode"`
+	CredentialsRotatedAt          types.String      `tfsdk:"credentials_rotated_at"`
 }
 
 // integrationResource is the resource implementation.
 type integrationResource struct {
-	client *nangoClient
+	client *nango.Client
 }
 
 // Metadata returns the resource type name.
@@ -56,10 +67,20 @@ func (r *integrationResource) Metadata(_ context.Context, req resource.MetadataR
 // Schema defines the schema for the resource.
 func (r *integrationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		// Version 1 replaced the flat OAuth credentials with one nested
+		// object per credential type, see UpgradeState.
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
 			"unique_key": schema.StringAttribute{
 				Required:            true,
-				MarkdownDescription: "The integration ID that you created in Nango.",
+				MarkdownDescription: "The integration ID that you created in Nango. Changing this forces a new integration to be created unless `allow_rename` is `true`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						requiresReplaceUnlessRenameAllowed,
+						"Changing this forces a new integration to be created unless allow_rename is true.",
+						"Changing this forces a new integration to be created unless `allow_rename` is `true`.",
+					),
+				},
 			},
 			"display_name": schema.StringAttribute{
 				Required:            true,
@@ -67,42 +88,68 @@ func (r *integrationResource) Schema(_ context.Context, _ resource.SchemaRequest
 			},
 			"nango_provider": schema.StringAttribute{
 				Required:            true,
-				MarkdownDescription: "The nango_provider",
+				MarkdownDescription: "The nango_provider. Changing this forces a new integration to be created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"updated_at": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "Last time it was updated",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"credentials": schema.SingleNestedAttribute{
-				Required:            true,
-				MarkdownDescription: "The credentials for this integration",
-				Attributes: map[string]schema.Attribute{
-					"client_id": schema.StringAttribute{
-						Required:            true,
-						MarkdownDescription: "The client ID",
-					},
-					"client_secret": schema.StringAttribute{
-						Required:            true,
-						MarkdownDescription: "The client secret",
-					},
-					"type": schema.StringAttribute{
-						Required:            true,
-						MarkdownDescription: "The type of credential",
-					},
-					"scopes": schema.ListAttribute{
-						Optional:            true,
-						MarkdownDescription: "The scopes for this credential",
-						ElementType:         types.StringType,
-					},
+			"webhook_url": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The URL to register with the provider so that its webhooks reach Nango.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"webhook_secret": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The secret Nango uses to verify webhooks sent by the provider.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"forward_webhooks": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether Nango forwards webhooks received from the provider to the environment's webhook URLs. Defaults to Nango's setting, which is `true`.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"allow_rename": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "When `true`, changing `unique_key` renames the integration in place instead of replacing it, so existing connections are kept.",
+			},
+			"prevent_destroy_with_connections": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "When `true`, deleting the integration fails while it still has connections.",
+			},
+			"rotation_mode": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "When `true`, a new `client_secret`, `private_key` or `client_secret_wo_version` is rolled out as a rotation: only the credentials are sent to Nango, and they are read back to check that the new secret took effect before any other change is applied. A secret Nango does not return counts as applied only if the integration's `updated_at` changed; otherwise the apply fails.",
+			},
+			"credentials_rotated_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "When the credentials were last rotated with `rotation_mode`. Null until the first rotation.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"credentials": credentialsSchema(),
 		},
 	}
 }
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *integrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan integrationModel
+	var plan integrationResourceModel
 
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -110,66 +157,49 @@ func (r *integrationResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
-	// Convert scopes from types.List to []string, then to comma-delimited string
-	var scopes []string
-	plan.Credentials.Scopes.ElementsAs(ctx, &scopes, false)
-	scopesString := strings.Join(scopes, ",")
-
-	// Populate the request model with data from the plan
-	request := integrationRequestModel{
-		UniqueKey:     plan.UniqueKey.ValueStringPointer(),
-		DisplayName:   plan.DisplayName.ValueString(),
-		NangoProvider: plan.NangoProvider.ValueStringPointer(),
-		Credentials: integrationCredentialsRequestModel{
-			ClientId:     plan.Credentials.ClientId.ValueString(),
-			ClientSecret: plan.Credentials.ClientSecret.ValueString(),
-			Type:         plan.Credentials.Type.ValueString(),
-			Scopes:       scopesString, // Now a comma-delimited string
-		},
+	resp.Diagnostics.Append(copyWriteOnlyCredentials(ctx, req.Config, plan.Credentials)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	// Convert request to JSON
-	requestBody, err := json.Marshal(request)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable to Marshal JSON",
-			err.Error(),
-		)
+	credentials, diags := plan.Credentials.toRequest(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Create a new request with the JSON body
-	_, err = r.client.client.Post(r.client.baseURL+"/integrations", "application/json", requestBody)
+	// Populate the request model with data from the plan
+	request := nango.CreateIntegrationRequest{
+		UniqueKey:       plan.UniqueKey.ValueString(),
+		DisplayName:     plan.DisplayName.ValueString(),
+		Provider:        plan.NangoProvider.ValueString(),
+		Credentials:     credentials,
+		ForwardWebhooks: plan.ForwardWebhooks.ValueBoolPointer(),
+	}
+
+	_, err := r.client.CreateIntegration(ctx, request)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable to Create Integration",
-			err.Error(),
-		)
+		addNangoError(&resp.Diagnostics, "Unable to Create Integration", err, integrationAttributePath(plan.Credentials))
 		return
 	}
 
-	getResponse, gErr := r.client.client.Get(r.client.baseURL + "/integrations/" + plan.UniqueKey.ValueString() + "?include=webhook&include=credentials")
-	if gErr != nil {
-		resp.Diagnostics.AddError(
-			"Unable to Get Integration",
-			gErr.Error(),
-		)
+	// Record the integration before reading it back, so that a failed read
+	// leaves it tainted in state instead of orphaned in Nango.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("unique_key"), plan.UniqueKey)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	//unmarshal response body to integrationModel
-	var integration nanogoIntegrationResponse2
-	err = json.NewDecoder(getResponse.Body).Decode(&integration)
+	integration, err := r.client.GetIntegration(ctx, plan.UniqueKey.ValueString(), nango.IncludeWebhook, nango.IncludeCredentials)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable to Decode JSON",
-			err.Error(),
-		)
+		addNangoError(&resp.Diagnostics, "Unable to Get Integration", err, nil)
 		return
 	}
 
-	plan.UniqueKey = types.StringValue(integration.Data.UniqueKey)
-	plan.UpdatedAt = types.StringValue(integration.Data.UpdatedAt)
+	plan.UniqueKey = types.StringValue(integration.UniqueKey)
+	plan.UpdatedAt = types.StringValue(integration.UpdatedAt)
+	plan.CredentialsRotatedAt = types.StringNull()
+	refreshWebhookState(integration, &plan)
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
@@ -183,7 +213,7 @@ func (r *integrationResource) Create(ctx context.Context, req resource.CreateReq
 // Read refreshes the Terraform state with the latest data.
 func (r *integrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var state integrationModel
+	var state integrationResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -191,43 +221,21 @@ func (r *integrationResource) Read(ctx context.Context, req resource.ReadRequest
 	}
 
 	// Get refreshed integration value from Nango, including credentials/scopes
-	integrationResponse, err := r.client.client.Get(r.client.baseURL + "/integrations/" + state.UniqueKey.ValueString() + "?include=credentials")
-
-  if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading Nango Integration",
-			"Could not read Nango integration "+state.UniqueKey.ValueString()+": "+err.Error(),
-		)
+	integration, err := r.client.GetIntegration(ctx, state.UniqueKey.ValueString(), nango.IncludeWebhook, nango.IncludeCredentials)
+	if nango.IsNotFound(err) {
+		// Deleted outside of Terraform; drop it so the next plan recreates it.
+		resp.State.RemoveResource(ctx)
 		return
 	}
-
-	var integrationResp nanogoIntegrationResponse2
-	err = json.NewDecoder(integrationResponse.Body).Decode(&integrationResp)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable to Decode JSON",
-			err.Error(),
-		)
+		addNangoError(&resp.Diagnostics, "Error Reading Nango Integration "+state.UniqueKey.ValueString(), err, nil)
 		return
 	}
 
 	// Overwrite items with refreshed state from the API
-	if integrationResp.Data.UpdatedAt != "" {
-		state.UpdatedAt = types.StringValue(integrationResp.Data.UpdatedAt)
-	}
-
-	// Parse scopes from API response back into types.List so Terraform can detect drift
-	if integrationResp.Data.Credentials != nil && integrationResp.Data.Credentials.Scopes != "" {
-		scopeStrings := strings.Split(integrationResp.Data.Credentials.Scopes, ",")
-		scopeValues := make([]attr.Value, len(scopeStrings))
-		for i, s := range scopeStrings {
-			scopeValues[i] = types.StringValue(strings.TrimSpace(s))
-		}
-		scopesList, scopeDiags := types.ListValue(types.StringType, scopeValues)
-		resp.Diagnostics.Append(scopeDiags...)
-		if !resp.Diagnostics.HasError() && state.Credentials != nil {
-			state.Credentials.Scopes = scopesList
-		}
+	resp.Diagnostics.Append(refreshIntegrationState(integration, &state, r.scopeSeparator(ctx, integration))...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	// Set refreshed state
@@ -240,7 +248,7 @@ func (r *integrationResource) Read(ctx context.Context, req resource.ReadRequest
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *integrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan integrationModel
+	var plan integrationResourceModel
 
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -248,77 +256,105 @@ func (r *integrationResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
-	// Convert scopes from types.List to []string, then to comma-delimited string
-	var scopes []string
-	plan.Credentials.Scopes.ElementsAs(ctx, &scopes, false)
-	scopesString := strings.Join(scopes, ",")
+	var state integrationResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	// Populate the request model with data from the plan (excluding unique_key and provider for updates)
-	// unique_key must NOT be in the body — Nango interprets it as a rename attempt
-	request := integrationRequestModel{
-		DisplayName: plan.DisplayName.ValueString(),
-		Credentials: integrationCredentialsRequestModel{
-			ClientId:     plan.Credentials.ClientId.ValueString(),
-			ClientSecret: plan.Credentials.ClientSecret.ValueString(),
-			Type:         plan.Credentials.Type.ValueString(),
-			Scopes:       scopesString, // Now a comma-delimited string
-		},
+	// ModifyPlan marks credentials_rotated_at unknown for a rotation that may
+	// turn out not to happen, for example when the credentials were unknown
+	// at plan time. Keep the prior value unless the rotation below sets it.
+	if plan.CredentialsRotatedAt.IsUnknown() {
+		plan.CredentialsRotatedAt = state.CredentialsRotatedAt
 	}
 
-	// Convert request to JSON
-	requestBody, err := json.Marshal(request)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable to Marshal JSON",
-			err.Error(),
-		)
+	// Only Terraform-side settings such as prevent_destroy_with_connections
+	// changed; there is nothing to send to Nango.
+	needsPatch, diags := integrationNeedsPatch(ctx, req.Plan, req.State)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-
-	// Create a PATCH request to update the integration
-	req2, err := retryablehttp.NewRequest("PATCH", r.client.baseURL+"/integrations/"+plan.UniqueKey.ValueString(), requestBody)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable to Create Request",
-			err.Error(),
-		)
+	if !needsPatch {
+		// Likewise for updated_at, when values unknown at plan time turned
+		// out unchanged.
+		if plan.UpdatedAt.IsUnknown() {
+			plan.UpdatedAt = state.UpdatedAt
+		}
+		diags = resp.State.Set(ctx, plan)
+		resp.Diagnostics.Append(diags...)
 		return
 	}
-	req2.Header.Set("Content-Type", "application/json")
 
-	response, err := r.client.client.Do(req2)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable to Update Integration",
-			err.Error(),
-		)
+	resp.Diagnostics.Append(copyWriteOnlyCredentials(ctx, req.Config, plan.Credentials)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	if response.StatusCode != 200 {
-		bodyBytes, _ := json.Marshal(response.Body)
-		resp.Diagnostics.AddError(
-			"Nango API Error",
-			fmt.Sprintf("PATCH /integrations/%s returned HTTP %d: %s", plan.UniqueKey.ValueString(), response.StatusCode, string(bodyBytes)),
-		)
+	credentials, diags := plan.Credentials.toRequest(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Unmarshal response body to integrationModel
-	var integration nangoIntegrationModel
-	err = json.NewDecoder(response.Body).Decode(&integration)
+	// Populate the request model with data from the plan (excluding unique_key and provider for updates)
+	// unique_key must NOT be in the body unless renaming — Nango interprets it as a rename attempt
+	request := nango.PatchIntegrationRequest{
+		DisplayName:     plan.DisplayName.ValueStringPointer(),
+		Credentials:     credentials,
+		ForwardWebhooks: plan.ForwardWebhooks.ValueBoolPointer(),
+	}
+
+	// unique_key only reaches Update unchanged or with allow_rename set, see
+	// requiresReplaceUnlessRenameAllowed.
+	if !plan.UniqueKey.Equal(state.UniqueKey) {
+		request.UniqueKey = plan.UniqueKey.ValueStringPointer()
+	}
+
+	if plan.RotationMode.ValueBool() && plan.Credentials.secretsChanged(state.Credentials) {
+		integration, diags := r.rotateCredentials(ctx, state.UniqueKey.ValueString(), credentials, plan.Credentials, state.UpdatedAt.ValueString())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		plan.CredentialsRotatedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+		if integration.UpdatedAt != "" {
+			plan.UpdatedAt = types.StringValue(integration.UpdatedAt)
+		} else {
+			plan.UpdatedAt = types.StringValue(time.Now().Format(time.RFC3339))
+		}
+
+		// The credentials are in place; send the remaining changes, if
+		// any, without them.
+		request.Credentials = nil
+		if request.UniqueKey == nil && plan.DisplayName.Equal(state.DisplayName) && plan.ForwardWebhooks.Equal(state.ForwardWebhooks) {
+			diags = resp.State.Set(ctx, plan)
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+
+		// Record the rotation before the second request, so that it is
+		// not lost, and retried, when that request fails.
+		rotated := state
+		rotated.RotationMode = plan.RotationMode
+		rotated.Credentials = plan.Credentials.withoutWriteOnly()
+		rotated.CredentialsRotatedAt = plan.CredentialsRotatedAt
+		rotated.UpdatedAt = plan.UpdatedAt
+		resp.Diagnostics.Append(resp.State.Set(ctx, rotated)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	integration, err := r.client.PatchIntegration(ctx, state.UniqueKey.ValueString(), request)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable to Decode JSON",
-			err.Error(),
-		)
+		addNangoError(&resp.Diagnostics, "Unable to Update Integration", err, integrationAttributePath(plan.Credentials))
 		return
 	}
 
-	// Update the plan with response data if available, otherwise use plan values
-	if integration.DisplayName != "" {
-		plan.DisplayName = types.StringValue(integration.DisplayName)
-	}
+	// updated_at is the only value Nango computes; everything else was planned
 	if integration.UpdatedAt != "" {
 		plan.UpdatedAt = types.StringValue(integration.UpdatedAt)
 	} else {
@@ -333,8 +369,329 @@ func (r *integrationResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 }
 
+// ModifyPlan checks the credentials against the provider's auth mode and
+// marks updated_at as unknown only when the plan changes something that is
+// sent to Nango, so unrelated changes keep the prior timestamp. Likewise,
+// credentials_rotated_at is only marked unknown for a rotation.
+func (r *integrationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	needsPatch := true
+	if !req.State.Raw.IsNull() {
+		var diags diag.Diagnostics
+		needsPatch, diags = integrationNeedsPatch(ctx, req.Plan, req.State)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if needsPatch {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("updated_at"), types.StringUnknown())...)
+
+			rotating, diags := credentialsRotating(ctx, req.Plan, req.State)
+			resp.Diagnostics.Append(diags...)
+			if rotating {
+				resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("credentials_rotated_at"), types.StringUnknown())...)
+			}
+		}
+	}
+
+	if needsPatch && r.client != nil {
+		resp.Diagnostics.Append(r.validateProviderAuthMode(ctx, req.Plan)...)
+	}
+}
+
+// credentialsRotating reports whether rotation_mode is set and the plan
+// changes a secret, in which case Update rotates the credentials.
+func credentialsRotating(ctx context.Context, plan tfsdk.Plan, state tfsdk.State) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var rotationMode types.Bool
+	var credentialsObject types.Object
+	diags.Append(plan.GetAttribute(ctx, path.Root("rotation_mode"), &rotationMode)...)
+	diags.Append(plan.GetAttribute(ctx, path.Root("credentials"), &credentialsObject)...)
+	if diags.HasError() || !rotationMode.ValueBool() {
+		return false, diags
+	}
+	if credentialsObject.IsUnknown() {
+		return true, diags
+	}
+
+	var planned, prior *credentialsModel
+	diags.Append(plan.GetAttribute(ctx, path.Root("credentials"), &planned)...)
+	diags.Append(state.GetAttribute(ctx, path.Root("credentials"), &prior)...)
+	if diags.HasError() {
+		return false, diags
+	}
+	return planned.secretsChanged(prior), diags
+}
+
+// rotateCredentials sends only the credentials to Nango, then reads the
+// integration back to check that the new secrets took effect. Secrets Nango
+// does not return are checked through updated_at moving on from
+// priorUpdatedAt.
+func (r *integrationResource) rotateCredentials(ctx context.Context, uniqueKey string, credentials *nango.IntegrationCredentialsRequest, model *credentialsModel, priorUpdatedAt string) (*nango.Integration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	_, err := r.client.PatchIntegration(ctx, uniqueKey, nango.PatchIntegrationRequest{Credentials: credentials})
+	if err != nil {
+		addNangoError(&diags, "Unable to Rotate Integration Credentials", err, integrationAttributePath(model))
+		return nil, diags
+	}
+
+	integration, err := r.client.GetIntegration(ctx, uniqueKey, nango.IncludeWebhook, nango.IncludeCredentials)
+	if err != nil {
+		addNangoError(&diags, "Unable to Verify Integration Credentials", err, nil)
+		return nil, diags
+	}
+	updatedAtChanged := integration.UpdatedAt != "" && integration.UpdatedAt != priorUpdatedAt
+	diags.Append(model.verifyRotation(credentials, integration.Credentials, updatedAtChanged)...)
+	return integration, diags
+}
+
+// validateProviderAuthMode looks up the planned provider in the Nango
+// catalog and checks that the planned credentials match its auth mode.
+func (r *integrationResource) validateProviderAuthMode(ctx context.Context, plan tfsdk.Plan) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var providerName types.String
+	var credentialsObject types.Object
+	diags.Append(plan.GetAttribute(ctx, path.Root("nango_provider"), &providerName)...)
+	diags.Append(plan.GetAttribute(ctx, path.Root("credentials"), &credentialsObject)...)
+	if diags.HasError() || providerName.IsUnknown() || credentialsObject.IsUnknown() {
+		return diags
+	}
+
+	var credentials *credentialsModel
+	diags.Append(plan.GetAttribute(ctx, path.Root("credentials"), &credentials)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	provider, err := r.client.GetProvider(ctx, providerName.ValueString())
+	if nango.IsNotFound(err) {
+		diags.AddAttributeError(
+			path.Root("nango_provider"),
+			"Unknown Nango Provider",
+			"Provider "+providerName.ValueString()+" is not in the Nango provider catalog.",
+		)
+		return diags
+	}
+	if err != nil {
+		// The catalog is only used for validation; let the API have the
+		// final word if it cannot be reached.
+		diags.AddAttributeWarning(
+			path.Root("nango_provider"),
+			"Unable to Validate Nango Provider",
+			"Could not look up provider "+providerName.ValueString()+": "+err.Error(),
+		)
+		return diags
+	}
+
+	diags.Append(validateAuthMode(provider, credentials)...)
+	return diags
+}
+
+// ValidateConfig checks that exactly one kind of credentials is configured,
+// with exactly one client secret.
+func (r *integrationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var credentials types.Object
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("credentials"), &credentials)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(validateCredentialsObject(credentials)...)
+	resp.Diagnostics.Append(validateClientSecrets(credentials)...)
+}
+
+// copyWriteOnlyCredentials copies the write-only secrets from config into
+// credentials, since they are null in the plan.
+func copyWriteOnlyCredentials(ctx context.Context, config tfsdk.Config, credentials *credentialsModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if credentials == nil {
+		return diags
+	}
+
+	var configCredentials *credentialsModel
+	diags.Append(config.GetAttribute(ctx, path.Root("credentials"), &configCredentials)...)
+	if diags.HasError() {
+		return diags
+	}
+	credentials.copyWriteOnly(configCredentials)
+	return diags
+}
+
+// requiresReplaceUnlessRenameAllowed replaces the integration when unique_key
+// changes, unless allow_rename is set in the plan.
+func requiresReplaceUnlessRenameAllowed(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	var allowRename types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("allow_rename"), &allowRename)...)
+	resp.RequiresReplace = !allowRename.ValueBool()
+}
+
+// integrationNeedsPatch reports whether plan differs from state in any
+// attribute that is stored in Nango.
+func integrationNeedsPatch(ctx context.Context, plan tfsdk.Plan, state tfsdk.State) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	for _, name := range []string{"unique_key", "display_name", "credentials", "forward_webhooks"} {
+		var planValue, stateValue attr.Value
+		diags.Append(plan.GetAttribute(ctx, path.Root(name), &planValue)...)
+		diags.Append(state.GetAttribute(ctx, path.Root(name), &stateValue)...)
+		if diags.HasError() {
+			return false, diags
+		}
+		if !planValue.Equal(stateValue) {
+			return true, diags
+		}
+	}
+	return false, diags
+}
+
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *integrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state integrationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	uniqueKey := state.UniqueKey.ValueString()
+
+	if state.PreventDestroyWithConnections.ValueBool() {
+		count, err := r.countConnections(ctx, uniqueKey)
+		if err != nil {
+			addNangoError(&resp.Diagnostics, "Unable to List Connections for Integration "+uniqueKey, err, nil)
+			return
+		}
+		if count > 0 {
+			resp.Diagnostics.AddError(
+				"Integration Has Connections",
+				fmt.Sprintf("Nango integration %s still has %d connection(s) and prevent_destroy_with_connections is set. "+
+					"Delete the connections first or unset prevent_destroy_with_connections.", uniqueKey, count),
+			)
+			return
+		}
+	}
+
+	err := r.client.DeleteIntegration(ctx, uniqueKey)
+	// Already gone, e.g. deleted from the dashboard; nothing left to do.
+	if err != nil && !nango.IsNotFound(err) {
+		addNangoError(&resp.Diagnostics, "Unable to Delete Integration", err, nil)
+		return
+	}
+}
+
+// countConnections returns the number of connections that use the given integration.
+func (r *integrationResource) countConnections(ctx context.Context, uniqueKey string) (int, error) {
+	connections, err := r.client.ListConnections(ctx, nango.ListConnectionsOptions{IntegrationID: uniqueKey})
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for _, connection := range connections {
+		if connection.ProviderConfigKey == uniqueKey {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// refreshIntegrationState copies every field Nango returns for an integration
+// into state so that changes made outside of Terraform show up as a diff.
+// Scopes are split on scopeSeparator, see scopeSeparator.
+func refreshIntegrationState(integration *nango.Integration, state *integrationResourceModel, scopeSeparator string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	state.UniqueKey = types.StringValue(integration.UniqueKey)
+	state.DisplayName = types.StringValue(integration.DisplayName)
+	state.NangoProvider = types.StringValue(integration.Provider)
+	if integration.UpdatedAt != "" {
+		state.UpdatedAt = types.StringValue(integration.UpdatedAt)
+	}
+
+	refreshWebhookState(integration, state)
+
+	credentials, credentialsDiags := refreshCredentials(integration.Credentials, state.Credentials, scopeSeparator)
+	diags.Append(credentialsDiags...)
+	state.Credentials = credentials
+
+	return diags
+}
+
+// scopeSeparator returns the scope separator of the integration's provider in
+// the Nango catalog, which the client caches. The catalog is only needed to
+// parse scopes, so the default separator is used when there are none or the
+// catalog cannot be reached.
+func (r *integrationResource) scopeSeparator(ctx context.Context, integration *nango.Integration) string {
+	if integration.Credentials == nil || integration.Credentials.Scopes == "" {
+		return defaultScopeSeparator
+	}
+
+	provider, err := r.client.GetProvider(ctx, integration.Provider)
+	if err != nil {
+		return defaultScopeSeparator
+	}
+	return scopeSeparator(provider)
+}
+
+// refreshWebhookState copies the webhook settings of an integration fetched
+// with nango.IncludeWebhook and nango.IncludeCredentials into state.
+func refreshWebhookState(integration *nango.Integration, state *integrationResourceModel) {
+	state.WebhookURL = types.StringValue(integration.WebhookURL)
+
+	state.WebhookSecret = types.StringNull()
+	if integration.Credentials != nil && integration.Credentials.WebhookSecret != "" {
+		state.WebhookSecret = types.StringValue(integration.Credentials.WebhookSecret)
+	}
+
+	if integration.ForwardWebhooks != nil {
+		state.ForwardWebhooks = types.BoolPointerValue(integration.ForwardWebhooks)
+	} else if state.ForwardWebhooks.IsNull() || state.ForwardWebhooks.IsUnknown() {
+		state.ForwardWebhooks = types.BoolValue(true)
+	}
+}
+
+// integrationAttributePath returns a function that maps fields of the
+// integration request body to their schema attributes. Credential fields are
+// mapped into the nested object configured in credentials.
+func integrationAttributePath(credentials *credentialsModel) attributePathFunc {
+	return func(field []string) (path.Path, bool) {
+		if len(field) == 0 {
+			return path.Empty(), false
+		}
+
+		switch field[0] {
+		case "unique_key", "display_name":
+			return path.Root(field[0]), true
+		case "provider":
+			return path.Root("nango_provider"), true
+		case "credentials":
+			kind := credentials.kind()
+			if kind == "" {
+				return path.Root("credentials"), true
+			}
+			if len(field) == 1 {
+				return path.Root("credentials").AtName(kind), true
+			}
+			switch field[1] {
+			case "client_secret":
+				if credentials.usesClientSecretWo() {
+					return path.Root("credentials").AtName(kind).AtName("client_secret_wo"), true
+				}
+				return path.Root("credentials").AtName(kind).AtName(field[1]), true
+			case "client_id", "scopes", "app_id", "app_link", "private_key":
+				return path.Root("credentials").AtName(kind).AtName(field[1]), true
+			}
+			return path.Root("credentials").AtName(kind), true
+		}
+		return path.Empty(), false
+	}
 }
 
 // Configure adds the provider configured client to the resource.
@@ -345,12 +702,12 @@ func (r *integrationResource) Configure(_ context.Context, req resource.Configur
 		return
 	}
 
-	client, ok := req.ProviderData.(*nangoClient)
+	client, ok := req.ProviderData.(*nango.Client)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *nangoClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *nango.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -362,5 +719,164 @@ func (r *integrationResource) Configure(_ context.Context, req resource.Configur
 // ImportState imports the resource into Terraform state.
 func (r *integrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// The import ID should be the unique_key of the integration
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("unique_key"), req.ID)...)
+	integration, err := r.client.GetIntegration(ctx, req.ID, nango.IncludeWebhook, nango.IncludeCredentials)
+	if err != nil {
+		addNangoError(&resp.Diagnostics, "Unable to Import Integration "+req.ID, err, nil)
+		return
+	}
+
+	var state integrationResourceModel
+	resp.Diagnostics.Append(refreshIntegrationState(integration, &state, r.scopeSeparator(ctx, integration))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if secretPath, secret, ok := state.Credentials.secretPath(); ok && secret.IsNull() {
+		resp.Diagnostics.AddAttributeWarning(
+			secretPath,
+			"Secret Not Imported",
+			"Nango did not return the secret for integration "+req.ID+". "+
+				"Set "+secretPath.String()+" in the configuration, or its write-only variant where available; the first apply after import will write it back to Nango.",
+		)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// integrationResourceModelV0 maps schema version 0, where credentials held
+// OAuth fields and a free-form type.
+type integrationResourceModelV0 struct {
+	UniqueKey                     types.String                   `tfsdk:"unique_key"`
+	DisplayName                   types.String                   `tfsdk:"display_name"`
+	NangoProvider                 types.String                   `tfsdk:"nango_provider"`
+	UpdatedAt                     types.String                   `tfsdk:"updated_at"`
+	Credentials                   *integrationCredentialsModelV0 `tfsdk:"credentials"`
+	AllowRename                   types.Bool                     `tfsdk:"allow_rename"`
+	PreventDestroyWithConnections types.Bool                     `tfsdk:"prevent_destroy_with_connections"`
+}
+
+// integrationCredentialsModelV0 maps the credentials of schema version 0. It
+// is kept separate from the data source models so that it stays frozen.
+type integrationCredentialsModelV0 struct {
+	ClientId     types.String `tfsdk:"client_id"`
+	ClientSecret types.String `tfsdk:"client_secret"`
+	Type         types.String `tfsdk:"type"`
+	Scopes       types.List   `tfsdk:"scopes"`
+}
+
+// UpgradeState moves version 0 credentials into the nested object that
+// matches their type.
+func (r *integrationResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"unique_key":                       schema.StringAttribute{Required: true},
+					"display_name":                     schema.StringAttribute{Required: true},
+					"nango_provider":                   schema.StringAttribute{Required: true},
+					"updated_at":                       schema.StringAttribute{Computed: true},
+					"allow_rename":                     schema.BoolAttribute{Optional: true},
+					"prevent_destroy_with_connections": schema.BoolAttribute{Optional: true},
+					"credentials": schema.SingleNestedAttribute{
+						Required: true,
+						Attributes: map[string]schema.Attribute{
+							"client_id":     schema.StringAttribute{Required: true},
+							"client_secret": schema.StringAttribute{Required: true},
+							"type":          schema.StringAttribute{Required: true},
+							"scopes":        schema.ListAttribute{Optional: true, ElementType: types.StringType},
+						},
+					},
+				},
+			},
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var prior integrationResourceModelV0
+				resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				upgraded := integrationResourceModel{
+					UniqueKey:                     prior.UniqueKey,
+					DisplayName:                   prior.DisplayName,
+					NangoProvider:                 prior.NangoProvider,
+					UpdatedAt:                     prior.UpdatedAt,
+					AllowRename:                   prior.AllowRename,
+					PreventDestroyWithConnections: prior.PreventDestroyWithConnections,
+				}
+
+				credentials, diags := upgradeCredentialsV0(ctx, prior.Credentials)
+				resp.Diagnostics.Append(diags...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+				upgraded.Credentials = credentials
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, upgraded)...)
+			},
+		},
+	}
+}
+
+// upgradeCredentialsV0 moves version 0 credentials into the nested object of
+// their type. Version 0 only stored the OAuth fields, so the app fields of APP
+// and CUSTOM credentials start out null and are filled in by the next refresh.
+func upgradeCredentialsV0(ctx context.Context, prior *integrationCredentialsModelV0) (*credentialsModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if prior == nil {
+		return nil, diags
+	}
+
+	var scopes []string
+	if !prior.Scopes.IsNull() {
+		diags.Append(prior.Scopes.ElementsAs(ctx, &scopes, false)...)
+	}
+	scopesValue, scopesDiags := scopesSet(scopes)
+	diags.Append(scopesDiags...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	oauth := &oauthCredentialsModel{
+		ClientId:              prior.ClientId,
+		ClientSecret:          prior.ClientSecret,
+		ClientSecretWo:        types.StringNull(),
+		ClientSecretWoVersion: types.Int64Null(),
+		Scopes:                scopesValue,
+	}
+
+	credentials := &credentialsModel{}
+	switch credentialType := strings.ToUpper(prior.Type.ValueString()); credentialType {
+	case nango.CredentialsOAuth2:
+		credentials.OAuth2 = oauth
+	case nango.CredentialsOAuth1:
+		credentials.OAuth1 = oauth
+	case nango.CredentialsTBA:
+		credentials.TBA = oauth
+	case nango.CredentialsApp:
+		credentials.App = &appCredentialsModel{
+			AppId:      types.StringNull(),
+			AppLink:    types.StringNull(),
+			PrivateKey: types.StringNull(),
+		}
+	case nango.CredentialsCustom:
+		credentials.Custom = &customCredentialsModel{
+			ClientId:              prior.ClientId,
+			ClientSecret:          prior.ClientSecret,
+			ClientSecretWo:        types.StringNull(),
+			ClientSecretWoVersion: types.Int64Null(),
+			AppId:                 types.StringNull(),
+			AppLink:               types.StringNull(),
+			PrivateKey:            types.StringNull(),
+		}
+	default:
+		diags.AddAttributeError(
+			path.Root("credentials").AtName("type"),
+			"Unsupported Credentials Type",
+			"Cannot upgrade state with credentials type "+prior.Type.String()+". "+
+				"Remove the integration from state with `terraform state rm` and import it again.",
+		)
+		return nil, diags
+	}
+	return credentials, diags
 }
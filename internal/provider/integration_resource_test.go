@@ -0,0 +1,261 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-nango/internal/nango"
+)
+
+// upgradeIntegrationStateV0 runs the version 0 state upgrader on prior and
+// returns the upgraded state.
+func upgradeIntegrationStateV0(t *testing.T, prior integrationResourceModelV0) (integrationResourceModel, diag.Diagnostics) {
+	t.Helper()
+	ctx := context.Background()
+
+	r := &integrationResource{}
+	upgrader := r.UpgradeState(ctx)[0]
+
+	priorState := tfsdk.State{Schema: *upgrader.PriorSchema}
+	if diags := priorState.Set(ctx, &prior); diags.HasError() {
+		t.Fatalf("setting prior state: %v", diags)
+	}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	resp := resource.UpgradeStateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	upgrader.StateUpgrader(ctx, resource.UpgradeStateRequest{State: &priorState}, &resp)
+
+	var upgraded integrationResourceModel
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.State.Get(ctx, &upgraded)...)
+	}
+	return upgraded, resp.Diagnostics
+}
+
+func testIntegrationStateV0(credentialType string) integrationResourceModelV0 {
+	return integrationResourceModelV0{
+		UniqueKey:                     types.StringValue("github"),
+		DisplayName:                   types.StringValue("GitHub"),
+		NangoProvider:                 types.StringValue("github"),
+		UpdatedAt:                     types.StringValue("2024-01-01T00:00:00Z"),
+		AllowRename:                   types.BoolNull(),
+		PreventDestroyWithConnections: types.BoolValue(true),
+		Credentials: &integrationCredentialsModelV0{
+			ClientId:     types.StringValue("client"),
+			ClientSecret: types.StringValue("secret"),
+			Type:         types.StringValue(credentialType),
+			Scopes: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("repo"),
+				types.StringValue("user"),
+				types.StringValue("repo"),
+			}),
+		},
+	}
+}
+
+func TestIntegrationUpgradeStateV0(t *testing.T) {
+	tests := map[string]struct {
+		credentialType string
+		wantKind       string
+	}{
+		"oauth2":           {credentialType: "OAUTH2", wantKind: "oauth2"},
+		"lowercase oauth1": {credentialType: "oauth1", wantKind: "oauth1"},
+		"tba":              {credentialType: "TBA", wantKind: "tba"},
+		"app":              {credentialType: "APP", wantKind: "app"},
+		"custom":           {credentialType: "CUSTOM", wantKind: "custom"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			upgraded, diags := upgradeIntegrationStateV0(t, testIntegrationStateV0(tt.credentialType))
+			if diags.HasError() {
+				t.Fatalf("upgrade: %v", diags)
+			}
+
+			if upgraded.UniqueKey.ValueString() != "github" || !upgraded.PreventDestroyWithConnections.ValueBool() {
+				t.Errorf("top-level attributes not carried over: %+v", upgraded)
+			}
+			if got := upgraded.Credentials.kind(); got != tt.wantKind {
+				t.Fatalf("kind = %q, want %q", got, tt.wantKind)
+			}
+
+			switch {
+			case upgraded.Credentials.oauth() != nil:
+				oauth := upgraded.Credentials.oauth()
+				if oauth.ClientSecret.ValueString() != "secret" {
+					t.Errorf("client_secret = %s, want secret", oauth.ClientSecret)
+				}
+				if got := len(oauth.Scopes.Elements()); got != 2 {
+					t.Errorf("got %d scopes, want 2 without the repeated one", got)
+				}
+			case upgraded.Credentials.Custom != nil:
+				if upgraded.Credentials.Custom.ClientId.ValueString() != "client" || !upgraded.Credentials.Custom.AppId.IsNull() {
+					t.Errorf("custom = %+v, want the client and a null app_id", upgraded.Credentials.Custom)
+				}
+			}
+		})
+	}
+}
+
+func TestIntegrationUpgradeStateV0UnknownType(t *testing.T) {
+	_, diags := upgradeIntegrationStateV0(t, testIntegrationStateV0("SOMETHING_NEW"))
+	if !diags.HasError() {
+		t.Fatal("upgrade: want an error for an unknown credentials type")
+	}
+}
+
+func TestIntegrationUpgradeStateV0WithoutCredentials(t *testing.T) {
+	prior := testIntegrationStateV0("OAUTH2")
+	prior.Credentials = nil
+
+	upgraded, diags := upgradeIntegrationStateV0(t, prior)
+	if diags.HasError() {
+		t.Fatalf("upgrade: %v", diags)
+	}
+	if upgraded.Credentials != nil {
+		t.Errorf("credentials = %+v, want nil", upgraded.Credentials)
+	}
+}
+
+// testIntegrationResource returns an integration resource whose client
+// talks to an httptest server running handler.
+func testIntegrationResource(t *testing.T, handler http.HandlerFunc) *integrationResource {
+	t.Helper()
+	return &integrationResource{client: testNangoClient(t, handler)}
+}
+
+// testNangoClient returns a client for a test server running handler,
+// without retries.
+func testNangoClient(t *testing.T, handler http.HandlerFunc) *nango.Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	retryClient := retryablehttp.NewClient()
+	retryClient.Logger = nil
+	retryClient.RetryMax = 0
+	return nango.NewClientWithHTTPClient(server.URL, retryClient)
+}
+
+// updateIntegration runs Update from prior to plan, using plan as the
+// configuration too, and returns the new state.
+func updateIntegration(t *testing.T, r *integrationResource, prior, plan integrationResourceModel) (integrationResourceModel, resource.UpdateResponse) {
+	t.Helper()
+	ctx := context.Background()
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	req := resource.UpdateRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema},
+		Plan:   tfsdk.Plan{Schema: schemaResp.Schema},
+		State:  tfsdk.State{Schema: schemaResp.Schema},
+	}
+	for _, diags := range []diag.Diagnostics{
+		req.Plan.Set(ctx, &plan),
+		req.State.Set(ctx, &prior),
+	} {
+		if diags.HasError() {
+			t.Fatalf("building request: %v", diags)
+		}
+	}
+	req.Config.Raw = req.Plan.Raw.Copy()
+
+	resp := resource.UpdateResponse{State: req.State}
+	r.Update(ctx, req, &resp)
+
+	var updated integrationResourceModel
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.State.Get(ctx, &updated)...)
+	}
+	return updated, resp
+}
+
+func testIntegrationState() integrationResourceModel {
+	oauth := testOAuthCredentials("secret")
+	return integrationResourceModel{
+		UniqueKey:                     types.StringValue("github"),
+		DisplayName:                   types.StringValue("GitHub"),
+		NangoProvider:                 types.StringValue("github"),
+		UpdatedAt:                     types.StringValue("2024-01-01T00:00:00Z"),
+		Credentials:                   &credentialsModel{OAuth2: &oauth},
+		WebhookURL:                    types.StringValue("https://api.nango.dev/webhook/github"),
+		WebhookSecret:                 types.StringNull(),
+		ForwardWebhooks:               types.BoolValue(false),
+		AllowRename:                   types.BoolNull(),
+		PreventDestroyWithConnections: types.BoolNull(),
+		RotationMode:                  types.BoolValue(true),
+		CredentialsRotatedAt:          types.StringValue("2024-01-01T00:00:00Z"),
+	}
+}
+
+func TestIntegrationUpdateWithoutRotationKeepsRotatedAt(t *testing.T) {
+	var patches int
+	r := testIntegrationResource(t, func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPatch {
+			t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+		}
+		patches++
+		_, _ = io.WriteString(w, `{"data": {"unique_key": "github", "updated_at": "2024-02-01T00:00:00Z"}}`)
+	})
+
+	prior := testIntegrationState()
+	plan := testIntegrationState()
+	plan.DisplayName = types.StringValue("GitHub App")
+	// As planned when the credentials were unknown at plan time.
+	plan.UpdatedAt = types.StringUnknown()
+	plan.CredentialsRotatedAt = types.StringUnknown()
+
+	updated, resp := updateIntegration(t, r, prior, plan)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Update: %v", resp.Diagnostics)
+	}
+	if patches != 1 {
+		t.Errorf("sent %d patches, want 1", patches)
+	}
+	if updated.CredentialsRotatedAt.ValueString() != "2024-01-01T00:00:00Z" {
+		t.Errorf("credentials_rotated_at = %s, want the prior value", updated.CredentialsRotatedAt)
+	}
+	if updated.UpdatedAt.ValueString() != "2024-02-01T00:00:00Z" {
+		t.Errorf("updated_at = %s, want the returned value", updated.UpdatedAt)
+	}
+}
+
+func TestIntegrationUpdateWithoutPatchKeepsComputedValues(t *testing.T) {
+	r := testIntegrationResource(t, func(w http.ResponseWriter, req *http.Request) {
+		t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+	})
+
+	prior := testIntegrationState()
+	plan := testIntegrationState()
+	plan.PreventDestroyWithConnections = types.BoolValue(true)
+	plan.UpdatedAt = types.StringUnknown()
+	plan.CredentialsRotatedAt = types.StringUnknown()
+
+	updated, resp := updateIntegration(t, r, prior, plan)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Update: %v", resp.Diagnostics)
+	}
+	if updated.UpdatedAt != prior.UpdatedAt || updated.CredentialsRotatedAt != prior.CredentialsRotatedAt {
+		t.Errorf("got updated_at %s and credentials_rotated_at %s, want the prior values", updated.UpdatedAt, updated.CredentialsRotatedAt)
+	}
+	if !updated.PreventDestroyWithConnections.ValueBool() {
+		t.Error("prevent_destroy_with_connections not updated")
+	}
+}
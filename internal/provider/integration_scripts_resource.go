@@ -0,0 +1,347 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-nango/internal/nango"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &integrationScriptsResource{}
+	_ resource.ResourceWithConfigure   = &integrationScriptsResource{}
+	_ resource.ResourceWithImportState = &integrationScriptsResource{}
+)
+
+// NewIntegrationScriptsResource is a helper function to simplify the provider implementation.
+func NewIntegrationScriptsResource() resource.Resource {
+	return &integrationScriptsResource{}
+}
+
+// integrationScriptsResourceModel maps the nango_integration_scripts resource schema data.
+type integrationScriptsResourceModel struct {
+	ProviderConfigKey types.String `tfsdk:"provider_config_key"`
+	Syncs             types.Set    `tfsdk:"syncs"`
+	Actions           types.Set    `tfsdk:"actions"`
+	AvailableSyncs    types.Set    `tfsdk:"available_syncs"`
+	AvailableActions  types.Set    `tfsdk:"available_actions"`
+}
+
+// integrationScriptsResource is the resource implementation.
+type integrationScriptsResource struct {
+	client *nango.Client
+}
+
+// Metadata returns the resource type name.
+func (r *integrationScriptsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_integration_scripts"
+}
+
+// Schema defines the schema for the resource.
+func (r *integrationScriptsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Declares which syncs and actions of a Nango integration are enabled. Scripts not listed are disabled.",
+		Attributes: map[string]schema.Attribute{
+			"provider_config_key": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The `unique_key` of the integration. Changing this forces a new resource to be created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"syncs": schema.SetAttribute{
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+				MarkdownDescription: "The names of the syncs to enable. Defaults to none.",
+			},
+			"actions": schema.SetAttribute{
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+				MarkdownDescription: "The names of the actions to enable. Defaults to none.",
+			},
+			"available_syncs": schema.SetAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The names of all syncs the integration offers",
+			},
+			"available_actions": schema.SetAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The names of all actions the integration offers",
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *integrationScriptsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan integrationScriptsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.applyScripts(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *integrationScriptsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state integrationScriptsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scripts, err := r.client.GetScripts(ctx, state.ProviderConfigKey.ValueString())
+	if err != nil {
+		addNangoError(&resp.Diagnostics, "Unable to Read Scripts of Integration "+state.ProviderConfigKey.ValueString(), err, nil)
+		return
+	}
+	if scripts == nil {
+		// The integration is gone, and its scripts with it.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	syncs, actions := enabledScripts(scripts)
+	availableSyncs, availableActions := availableScripts(scripts)
+	resp.Diagnostics.Append(setScriptNames(ctx, &state.Syncs, syncs)...)
+	resp.Diagnostics.Append(setScriptNames(ctx, &state.Actions, actions)...)
+	resp.Diagnostics.Append(setScriptNames(ctx, &state.AvailableSyncs, availableSyncs)...)
+	resp.Diagnostics.Append(setScriptNames(ctx, &state.AvailableActions, availableActions)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *integrationScriptsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan integrationScriptsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.applyScripts(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete disables the scripts this resource enabled.
+func (r *integrationScriptsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state integrationScriptsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var toggles []nango.ScriptToggle
+	for _, field := range []struct {
+		set        types.Set
+		scriptType string
+	}{
+		{state.Syncs, nango.ScriptTypeSync},
+		{state.Actions, nango.ScriptTypeAction},
+	} {
+		var names []string
+		resp.Diagnostics.Append(field.set.ElementsAs(ctx, &names, false)...)
+		for _, name := range names {
+			toggles = append(toggles, nango.ScriptToggle{Name: name, Type: field.scriptType, Enabled: false})
+		}
+	}
+	if resp.Diagnostics.HasError() || len(toggles) == 0 {
+		return
+	}
+
+	err := r.client.UpdateScripts(ctx, nango.UpdateScriptsRequest{
+		ProviderConfigKey: state.ProviderConfigKey.ValueString(),
+		Scripts:           toggles,
+	})
+	// Already gone along with the integration; nothing left to disable.
+	if err != nil && !nango.IsNotFound(err) {
+		addNangoError(&resp.Diagnostics, "Unable to Disable Scripts", err, nil)
+		return
+	}
+}
+
+// applyScripts enables and disables scripts so that exactly the planned
+// ones are enabled, and fills in the available scripts.
+func (r *integrationScriptsResource) applyScripts(ctx context.Context, plan *integrationScriptsResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	providerConfigKey := plan.ProviderConfigKey.ValueString()
+	scripts, err := r.client.GetScripts(ctx, providerConfigKey)
+	if err != nil {
+		addNangoError(&diags, "Unable to Read Scripts of Integration "+providerConfigKey, err, nil)
+		return diags
+	}
+	if scripts == nil {
+		diags.AddAttributeError(
+			path.Root("provider_config_key"),
+			"Integration Not Found",
+			"No integration with unique_key "+providerConfigKey+" exists in this Nango environment.",
+		)
+		return diags
+	}
+
+	var toggles []nango.ScriptToggle
+	for _, field := range []struct {
+		attribute  string
+		set        types.Set
+		available  []nango.Script
+		scriptType string
+	}{
+		{"syncs", plan.Syncs, scripts.Syncs, nango.ScriptTypeSync},
+		{"actions", plan.Actions, scripts.Actions, nango.ScriptTypeAction},
+	} {
+		var wanted []string
+		diags.Append(field.set.ElementsAs(ctx, &wanted, false)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		var names []string
+		for _, script := range field.available {
+			names = append(names, script.Name)
+			if enabled := slices.Contains(wanted, script.Name); enabled != script.Enabled {
+				toggles = append(toggles, nango.ScriptToggle{Name: script.Name, Type: field.scriptType, Enabled: enabled})
+			}
+		}
+		for _, name := range wanted {
+			if !slices.Contains(names, name) {
+				diags.AddAttributeError(
+					path.Root(field.attribute),
+					"Unknown Script",
+					fmt.Sprintf("Integration %s has no %s named %q. Available: %s.", providerConfigKey, field.scriptType, name, strings.Join(names, ", ")),
+				)
+			}
+		}
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	if len(toggles) > 0 {
+		err := r.client.UpdateScripts(ctx, nango.UpdateScriptsRequest{
+			ProviderConfigKey: providerConfigKey,
+			Scripts:           toggles,
+		})
+		if err != nil {
+			addNangoError(&diags, "Unable to Update Scripts", err, nil)
+			return diags
+		}
+	}
+
+	availableSyncs, availableActions := availableScripts(scripts)
+	diags.Append(setScriptNames(ctx, &plan.AvailableSyncs, availableSyncs)...)
+	diags.Append(setScriptNames(ctx, &plan.AvailableActions, availableActions)...)
+	return diags
+}
+
+// enabledScripts returns the names of the enabled syncs and actions.
+func enabledScripts(scripts *nango.IntegrationScripts) (syncs, actions []string) {
+	syncs, actions = []string{}, []string{}
+	for _, script := range scripts.Syncs {
+		if script.Enabled {
+			syncs = append(syncs, script.Name)
+		}
+	}
+	for _, script := range scripts.Actions {
+		if script.Enabled {
+			actions = append(actions, script.Name)
+		}
+	}
+	return syncs, actions
+}
+
+// availableScripts returns the names of all syncs and actions.
+func availableScripts(scripts *nango.IntegrationScripts) (syncs, actions []string) {
+	syncs, actions = []string{}, []string{}
+	for _, script := range scripts.Syncs {
+		syncs = append(syncs, script.Name)
+	}
+	for _, script := range scripts.Actions {
+		actions = append(actions, script.Name)
+	}
+	return syncs, actions
+}
+
+// setScriptNames stores script names in a set attribute.
+func setScriptNames(ctx context.Context, target *types.Set, names []string) diag.Diagnostics {
+	set, diags := types.SetValueFrom(ctx, types.StringType, names)
+	*target = set
+	return diags
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *integrationScriptsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*nango.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *nango.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ImportState imports the resource into Terraform state by the
+// integration's unique_key. The scripts enabled at import time become
+// managed.
+func (r *integrationScriptsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	state := integrationScriptsResourceModel{
+		ProviderConfigKey: types.StringValue(req.ID),
+		Syncs:             types.SetNull(types.StringType),
+		Actions:           types.SetNull(types.StringType),
+		AvailableSyncs:    types.SetNull(types.StringType),
+		AvailableActions:  types.SetNull(types.StringType),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
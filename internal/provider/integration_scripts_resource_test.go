@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-nango/internal/nango"
+)
+
+func testIntegrationScripts() *nango.IntegrationScripts {
+	return &nango.IntegrationScripts{
+		ProviderConfigKey: "github",
+		Syncs: []nango.Script{
+			{Name: "issues", Type: nango.ScriptTypeSync, Enabled: true},
+			{Name: "pull-requests", Type: nango.ScriptTypeSync},
+		},
+		Actions: []nango.Script{
+			{Name: "create-issue", Type: nango.ScriptTypeAction, Enabled: true},
+		},
+	}
+}
+
+func TestEnabledAndAvailableScripts(t *testing.T) {
+	tests := map[string]struct {
+		scripts                                  *nango.IntegrationScripts
+		wantEnabledSyncs, wantEnabledActions     []string
+		wantAvailableSyncs, wantAvailableActions []string
+	}{
+		"mixed": {
+			scripts:              testIntegrationScripts(),
+			wantEnabledSyncs:     []string{"issues"},
+			wantEnabledActions:   []string{"create-issue"},
+			wantAvailableSyncs:   []string{"issues", "pull-requests"},
+			wantAvailableActions: []string{"create-issue"},
+		},
+		"none": {
+			scripts:              &nango.IntegrationScripts{},
+			wantEnabledSyncs:     []string{},
+			wantEnabledActions:   []string{},
+			wantAvailableSyncs:   []string{},
+			wantAvailableActions: []string{},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			syncs, actions := enabledScripts(tt.scripts)
+			if !reflect.DeepEqual(syncs, tt.wantEnabledSyncs) || !reflect.DeepEqual(actions, tt.wantEnabledActions) {
+				t.Errorf("enabledScripts = %v, %v, want %v, %v", syncs, actions, tt.wantEnabledSyncs, tt.wantEnabledActions)
+			}
+			syncs, actions = availableScripts(tt.scripts)
+			if !reflect.DeepEqual(syncs, tt.wantAvailableSyncs) || !reflect.DeepEqual(actions, tt.wantAvailableActions) {
+				t.Errorf("availableScripts = %v, %v, want %v, %v", syncs, actions, tt.wantAvailableSyncs, tt.wantAvailableActions)
+			}
+		})
+	}
+}
+
+func TestApplyScripts(t *testing.T) {
+	ctx := context.Background()
+
+	tests := map[string]struct {
+		providerConfigKey string
+		syncs, actions    []string
+		wantToggles       []nango.ScriptToggle
+		wantError         string
+	}{
+		"unchanged": {
+			providerConfigKey: "github",
+			syncs:             []string{"issues"},
+			actions:           []string{"create-issue"},
+		},
+		"enable and disable": {
+			providerConfigKey: "github",
+			syncs:             []string{"pull-requests"},
+			actions:           []string{"create-issue"},
+			wantToggles: []nango.ScriptToggle{
+				{Name: "issues", Type: nango.ScriptTypeSync, Enabled: false},
+				{Name: "pull-requests", Type: nango.ScriptTypeSync, Enabled: true},
+			},
+		},
+		"disable all": {
+			providerConfigKey: "github",
+			syncs:             []string{},
+			actions:           []string{},
+			wantToggles: []nango.ScriptToggle{
+				{Name: "issues", Type: nango.ScriptTypeSync, Enabled: false},
+				{Name: "create-issue", Type: nango.ScriptTypeAction, Enabled: false},
+			},
+		},
+		"unknown script": {
+			providerConfigKey: "github",
+			syncs:             []string{"issues", "commits"},
+			actions:           []string{"create-issue"},
+			wantError:         "Unknown Script",
+		},
+		"unknown integration": {
+			providerConfigKey: "gitlab",
+			syncs:             []string{},
+			actions:           []string{},
+			wantError:         "Integration Not Found",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var gotToggles []nango.ScriptToggle
+			r := &integrationScriptsResource{client: testNangoClient(t, func(w http.ResponseWriter, req *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch req.Method {
+				case http.MethodGet:
+					_ = json.NewEncoder(w).Encode([]nango.IntegrationScripts{*testIntegrationScripts()})
+				case http.MethodPatch:
+					var body nango.UpdateScriptsRequest
+					if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+						t.Errorf("decoding request body: %v", err)
+					}
+					gotToggles = body.Scripts
+					_, _ = w.Write([]byte(`{}`))
+				}
+			})}
+
+			plan := integrationScriptsResourceModel{
+				ProviderConfigKey: types.StringValue(tt.providerConfigKey),
+				AvailableSyncs:    types.SetUnknown(types.StringType),
+				AvailableActions:  types.SetUnknown(types.StringType),
+			}
+			plan.Syncs, _ = types.SetValueFrom(ctx, types.StringType, tt.syncs)
+			plan.Actions, _ = types.SetValueFrom(ctx, types.StringType, tt.actions)
+
+			diags := r.applyScripts(ctx, &plan)
+			if tt.wantError != "" {
+				if !diags.HasError() || diags.Errors()[0].Summary() != tt.wantError {
+					t.Fatalf("diagnostics = %v, want error %q", diags, tt.wantError)
+				}
+				if gotToggles != nil {
+					t.Errorf("scripts toggled despite error: %v", gotToggles)
+				}
+				return
+			}
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if !reflect.DeepEqual(gotToggles, tt.wantToggles) {
+				t.Errorf("toggles = %v, want %v", gotToggles, tt.wantToggles)
+			}
+			wantAvailable, _ := types.SetValueFrom(ctx, types.StringType, []string{"issues", "pull-requests"})
+			if !plan.AvailableSyncs.Equal(wantAvailable) {
+				t.Errorf("available_syncs = %v, want %v", plan.AvailableSyncs, wantAvailable)
+			}
+		})
+	}
+}
@@ -0,0 +1,289 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-nango/internal/nango"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &integrationsDataSource{}
+	_ datasource.DataSourceWithConfigure = &integrationsDataSource{}
+)
+
+type integrationsDataSource struct {
+	client *nango.Client
+}
+
+type integrationsDataSourceModel struct {
+	NangoProvider   types.String       `tfsdk:"nango_provider"`
+	UniqueKeyPrefix types.String       `tfsdk:"unique_key_prefix"`
+	UniqueKeyRegex  types.String       `tfsdk:"unique_key_regex"`
+	AuthMode        types.String       `tfsdk:"auth_mode"`
+	IncludeSecrets  types.Bool         `tfsdk:"include_secrets"`
+	Integrations    []integrationModel `tfsdk:"integrations"`
+}
+type integrationModel struct {
+	UniqueKey     types.String                `tfsdk:"unique_key"`
+	DisplayName   types.String                `tfsdk:"display_name"`
+	NangoProvider types.String                `tfsdk:"nango_provider"`
+	AuthMode      types.String                `tfsdk:"auth_mode"`
+	UpdatedAt     types.String                `tfsdk:"updated_at"`
+	Credentials   *integrationCredentialModel `tfsdk:"credentials"`
+}
+
+type integrationCredentialModel struct {
+	ClientId     types.String `tfsdk:"client_id"`
+	ClientSecret types.String `tfsdk:"client_secret"`
+	Type         types.String `tfsdk:"type"`
+	Scopes       types.List   `tfsdk:"scopes"`
+	AppId        types.String `tfsdk:"app_id"`
+	AppLink      types.String `tfsdk:"app_link"`
+}
+
+// NewIntegrationsDataSource is a helper function to simplify the provider implementation.
+func NewIntegrationsDataSource() datasource.DataSource {
+	return &integrationsDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *integrationsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_integrations"
+}
+
+// Schema defines the schema for the data source.
+func (d *integrationsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"nango_provider": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return integrations of this provider.",
+			},
+			"unique_key_prefix": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return integrations whose unique key starts with this prefix.",
+			},
+			"unique_key_regex": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return integrations whose unique key matches this regular expression.",
+			},
+			"auth_mode": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return integrations whose provider uses this auth mode, for example `OAUTH2`.",
+			},
+			"include_secrets": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "When `true`, each integration's `client_secret` is included in `credentials`. Secrets are stored in state as sensitive values.",
+			},
+			"integrations": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"unique_key": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The integration ID that you created in Nango.",
+						},
+						"display_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The provider display name.",
+						},
+						"nango_provider": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The nango_provider",
+						},
+						"auth_mode": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The provider's auth mode. Null when the provider catalog cannot be read.",
+						},
+						"updated_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Last time it was updated",
+						},
+						"credentials": schema.SingleNestedAttribute{
+							Computed:            true,
+							MarkdownDescription: "The credentials for this integration. Null for providers without integration credentials.",
+							Attributes: map[string]schema.Attribute{
+								"client_id": schema.StringAttribute{
+									Computed:            true,
+									MarkdownDescription: "The client ID",
+								},
+								"client_secret": schema.StringAttribute{
+									Computed:            true,
+									Sensitive:           true,
+									MarkdownDescription: "The client secret. Only set when `include_secrets` is `true`.",
+								},
+								"type": schema.StringAttribute{
+									Computed:            true,
+									MarkdownDescription: "The type of credential",
+								},
+								"scopes": schema.ListAttribute{
+									Computed:            true,
+									MarkdownDescription: "The scopes for this credential",
+									ElementType:         types.StringType,
+								},
+								"app_id": schema.StringAttribute{
+									Computed:            true,
+									MarkdownDescription: "The app ID",
+								},
+								"app_link": schema.StringAttribute{
+									Computed:            true,
+									MarkdownDescription: "The public link to the app",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *integrationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state integrationsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var uniqueKeyRegex *regexp.Regexp
+	if !state.UniqueKeyRegex.IsNull() {
+		var err error
+		uniqueKeyRegex, err = regexp.Compile(state.UniqueKeyRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("unique_key_regex"),
+				"Invalid Regular Expression",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	integrations, err := d.client.ListIntegrations(ctx)
+	if err != nil {
+		addNangoError(&resp.Diagnostics, "Unable to Read Integrations", err, nil)
+		return
+	}
+
+	// Auth modes and scope separators come from the provider catalog, which
+	// is only read once and only if some integration passes the other
+	// filters.
+	var catalog map[string]nango.Provider
+	catalogRead := false
+
+	state.Integrations = []integrationModel{}
+	for _, integration := range integrations {
+		if !state.NangoProvider.IsNull() && integration.Provider != state.NangoProvider.ValueString() {
+			continue
+		}
+		if !strings.HasPrefix(integration.UniqueKey, state.UniqueKeyPrefix.ValueString()) {
+			continue
+		}
+		if uniqueKeyRegex != nil && !uniqueKeyRegex.MatchString(integration.UniqueKey) {
+			continue
+		}
+
+		if !catalogRead {
+			catalogRead = true
+			providers, err := d.client.ListProviders(ctx)
+			switch {
+			case err != nil && !state.AuthMode.IsNull():
+				addNangoError(&resp.Diagnostics, "Unable to Read Providers", err, nil)
+				return
+			case err != nil:
+				resp.Diagnostics.AddWarning(
+					"Unable to Read Providers",
+					"auth_mode is left null because the Nango provider catalog could not be read: "+err.Error(),
+				)
+			default:
+				catalog = make(map[string]nango.Provider, len(providers))
+				for _, provider := range providers {
+					catalog[provider.Name] = provider
+				}
+			}
+		}
+		provider, ok := catalog[integration.Provider]
+		authMode := provider.AuthMode
+		if !state.AuthMode.IsNull() && authMode != state.AuthMode.ValueString() {
+			continue
+		}
+
+		integ := integrationModel{
+			UniqueKey:     types.StringValue(integration.UniqueKey),
+			DisplayName:   types.StringValue(integration.DisplayName),
+			NangoProvider: types.StringValue(integration.Provider),
+			AuthMode:      types.StringNull(),
+			UpdatedAt:     types.StringValue(integration.UpdatedAt),
+		}
+		if ok {
+			integ.AuthMode = types.StringValue(authMode)
+		}
+
+		// The list endpoint does not return credentials, so they take a call
+		// per integration that passes the filters.
+		detail, err := d.client.GetIntegration(ctx, integration.UniqueKey, nango.IncludeCredentials)
+		if err != nil {
+			addNangoError(&resp.Diagnostics, "Unable to Read Integration "+integration.UniqueKey, err, nil)
+			return
+		}
+
+		metadata, diags := credentialsMetadata(ctx, detail.Credentials, scopeSeparator(&provider))
+		resp.Diagnostics.Append(diags...)
+		if metadata != nil {
+			integ.Credentials = &integrationCredentialModel{
+				ClientId:     metadata.ClientId,
+				ClientSecret: types.StringNull(),
+				Type:         metadata.Type,
+				Scopes:       metadata.Scopes,
+				AppId:        metadata.AppId,
+				AppLink:      metadata.AppLink,
+			}
+			// Secrets are opt-in.
+			if state.IncludeSecrets.ValueBool() {
+				integ.Credentials.ClientSecret = optionalString(detail.Credentials.ClientSecret)
+			}
+		}
+
+		state.Integrations = append(state.Integrations, integ)
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *integrationsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*nango.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *nango.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
@@ -4,27 +4,23 @@
 package provider
 
 import (
-	"bytes"
 	"context"
-	"fmt"
-	"io"
-	"log"
-	"net/http"
 	"os"
-	"strings"
-	"time"
 
-	"github.com/hashicorp/go-retryablehttp"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-nango/internal/nango"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ provider.Provider = &nangoProvider{}
+	_ provider.Provider                       = &nangoProvider{}
+	_ provider.ProviderWithEphemeralResources = &nangoProvider{}
 )
 
 // New is a helper function to simplify provider server and testing implementation.
@@ -41,12 +37,6 @@ type nangoProviderMdoel struct {
 	Host           types.String `tfsdk:"host"`
 }
 
-// nangoClient wraps the HTTP client and base URL for the Nango API.
-type nangoClient struct {
-	client  *retryablehttp.Client
-	baseURL string
-}
-
 // nangoProvider is the provider implementation.
 type nangoProvider struct {
 	// version is set to the provider version on release, "dev" when the
@@ -119,31 +109,23 @@ func (p *nangoProvider) Configure(ctx context.Context, req provider.ConfigureReq
 	if !config.Host.IsNull() && !config.Host.IsUnknown() {
 		host = config.Host.ValueString()
 	}
-	if host == "" {
-		host = "https://api.nango.dev"
-	}
-	host = strings.TrimRight(host, "/")
-
-	retryClient := retryablehttp.NewClient()
-	retryClient.RetryMax = 3                          // Maximum retry attempts
-	retryClient.RetryWaitMin = 1 * time.Second        // Minimum wait time between retries
-	retryClient.RetryWaitMax = 5 * time.Second        // Maximum wait time between retries
-	retryClient.HTTPClient.Timeout = 30 * time.Second // Set the timeout for the HTTP client
-	retryClient.HTTPClient.Transport = &myTransport{authKey: environmentKey, next: retryClient.HTTPClient.Transport}
-
-	nc := &nangoClient{
-		client:  retryClient,
-		baseURL: host,
-	}
+
+	nc := nango.NewClient(host, environmentKey)
 
 	resp.DataSourceData = nc
 	resp.ResourceData = nc
+	resp.EphemeralResourceData = nc
 }
 
 // DataSources defines the data sources implemented in the provider.
 func (p *nangoProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewIntegrationDataSource,
+		NewIntegrationsDataSource,
+		NewConnectionsDataSource,
+		NewSyncStatusDataSource,
+		NewProviderDataSource,
+		NewProvidersDataSource,
 	}
 }
 
@@ -151,40 +133,19 @@ func (p *nangoProvider) DataSources(_ context.Context) []func() datasource.DataS
 func (p *nangoProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewIntegrationResource,
+		NewConnectionResource,
+		NewConnectionMetadataResource,
+		NewSyncScheduleResource,
+		NewIntegrationScriptsResource,
+		NewEnvironmentSettingsResource,
+		NewEnvironmentVariableResource,
 	}
 }
 
-type myTransport struct {
-	authKey string
-	next    http.RoundTripper
-}
-
-func (t *myTransport) RoundTrip(req *http.Request) (*http.Response, error) {
-	fmt.Println("RoundTrip called")
-	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", t.authKey))
-	startTime := time.Now()
-
-	log.Printf("Request: %s %s %s", req.Method, req.URL.String(), req.Header["Authoriztaion"])
-	if req.Body != nil {
-		reqBody, _ := io.ReadAll(req.Body)
-		log.Printf("Request Body: %s", string(reqBody))
-		req.Body = io.NopCloser(bytes.NewBuffer(reqBody))
-	}
-
-	resp, err := t.next.RoundTrip(req)
-	if err != nil {
-		log.Printf("Error: %v", err)
-		return nil, err
+// EphemeralResources defines the ephemeral resources implemented in the provider.
+func (p *nangoProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewConnectSessionEphemeralResource,
+		NewConnectionTokenEphemeralResource,
 	}
-	defer resp.Body.Close()
-
-	elapsedTime := time.Since(startTime)
-	log.Printf("Response: %s %s - %d in %s", req.Method, req.URL.String(), resp.StatusCode, elapsedTime)
-
-	respBody, _ := io.ReadAll(resp.Body)
-	log.Printf("Response Body: %s", string(respBody))
-	resp.Body = io.NopCloser(bytes.NewBuffer(respBody))
-
-	return resp, nil
-	// return http.DefaultTransport.RoundTrip(req)
 }
@@ -0,0 +1,239 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-nango/internal/nango"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &providerDataSource{}
+	_ datasource.DataSourceWithConfigure = &providerDataSource{}
+)
+
+type providerDataSource struct {
+	client *nango.Client
+}
+
+// providerModel describes a provider of the catalog. It is shared by the
+// nango_provider and nango_providers data sources.
+type providerModel struct {
+	Name             types.String                 `tfsdk:"name"`
+	DisplayName      types.String                 `tfsdk:"display_name"`
+	LogoURL          types.String                 `tfsdk:"logo_url"`
+	AuthMode         types.String                 `tfsdk:"auth_mode"`
+	Categories       []types.String               `tfsdk:"categories"`
+	DocsURL          types.String                 `tfsdk:"docs_url"`
+	DefaultScopes    []types.String               `tfsdk:"default_scopes"`
+	ScopeSeparator   types.String                 `tfsdk:"scope_separator"`
+	ConnectionConfig []connectionConfigFieldModel `tfsdk:"connection_config"`
+}
+
+type connectionConfigFieldModel struct {
+	Name        types.String `tfsdk:"name"`
+	Type        types.String `tfsdk:"type"`
+	Title       types.String `tfsdk:"title"`
+	Description types.String `tfsdk:"description"`
+	Example     types.String `tfsdk:"example"`
+	Pattern     types.String `tfsdk:"pattern"`
+	Required    types.Bool   `tfsdk:"required"`
+}
+
+// NewProviderDataSource is a helper function to simplify the provider implementation.
+func NewProviderDataSource() datasource.DataSource {
+	return &providerDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *providerDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_provider"
+}
+
+// Schema defines the schema for the data source.
+func (d *providerDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := providerAttributes()
+	attributes["name"] = schema.StringAttribute{
+		Required:            true,
+		MarkdownDescription: "The provider name, as used in `nango_provider`, for example `google-calendar`.",
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up a single provider of the Nango catalog.",
+		Attributes:          attributes,
+	}
+}
+
+// providerAttributes returns the computed attributes of a catalog provider.
+func providerAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The provider name, as used in `nango_provider`",
+		},
+		"display_name": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The provider display name",
+		},
+		"logo_url": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "URL of the provider logo",
+		},
+		"auth_mode": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The provider's auth mode, for example `OAUTH2` or `API_KEY`",
+		},
+		"categories": schema.ListAttribute{
+			Computed:            true,
+			ElementType:         types.StringType,
+			MarkdownDescription: "The catalog categories of the provider",
+		},
+		"docs_url": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "URL of Nango's documentation for the provider",
+		},
+		"default_scopes": schema.ListAttribute{
+			Computed:            true,
+			ElementType:         types.StringType,
+			MarkdownDescription: "The scopes Nango requests when an integration sets none",
+		},
+		"scope_separator": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The separator the provider expects between scopes. Defaults to a space.",
+		},
+		"connection_config": schema.ListNestedAttribute{
+			Computed:            true,
+			MarkdownDescription: "The connection configuration values the provider needs, sorted by name",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The key in `connection_config`",
+					},
+					"type": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The value type",
+					},
+					"title": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "A short title",
+					},
+					"description": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "What the value is and where to find it",
+					},
+					"example": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "An example value",
+					},
+					"pattern": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "A regular expression the value must match",
+					},
+					"required": schema.BoolAttribute{
+						Computed:            true,
+						MarkdownDescription: "Whether connections must set the value",
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *providerDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state providerModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.Name.ValueString()
+	provider, err := d.client.GetProvider(ctx, name)
+	if nango.IsNotFound(err) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Provider Not Found",
+			"Nango has no provider named "+name+".",
+		)
+		return
+	}
+	if err != nil {
+		addNangoError(&resp.Diagnostics, "Unable to Read Provider "+name, err, nil)
+		return
+	}
+
+	state = newProviderModel(provider)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// newProviderModel converts a catalog provider.
+func newProviderModel(provider *nango.Provider) providerModel {
+	model := providerModel{
+		Name:             types.StringValue(provider.Name),
+		DisplayName:      types.StringValue(provider.DisplayName),
+		LogoURL:          optionalString(provider.LogoURL),
+		AuthMode:         types.StringValue(provider.AuthMode),
+		Categories:       []types.String{},
+		DocsURL:          optionalString(provider.Docs),
+		DefaultScopes:    []types.String{},
+		ScopeSeparator:   types.StringValue(scopeSeparator(provider)),
+		ConnectionConfig: []connectionConfigFieldModel{},
+	}
+	for _, category := range provider.Categories {
+		model.Categories = append(model.Categories, types.StringValue(category))
+	}
+	for _, scope := range provider.DefaultScopes {
+		model.DefaultScopes = append(model.DefaultScopes, types.StringValue(scope))
+	}
+
+	names := make([]string, 0, len(provider.ConnectionConfig))
+	for name := range provider.ConnectionConfig {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		field := provider.ConnectionConfig[name]
+		model.ConnectionConfig = append(model.ConnectionConfig, connectionConfigFieldModel{
+			Name:        types.StringValue(name),
+			Type:        optionalString(field.Type),
+			Title:       optionalString(field.Title),
+			Description: optionalString(field.Description),
+			Example:     optionalString(field.Example),
+			Pattern:     optionalString(field.Pattern),
+			Required:    types.BoolValue(!field.Optional),
+		})
+	}
+	return model
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *providerDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*nango.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *nango.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-nango/internal/nango"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &providersDataSource{}
+	_ datasource.DataSourceWithConfigure = &providersDataSource{}
+)
+
+type providersDataSource struct {
+	client *nango.Client
+}
+
+type providersDataSourceModel struct {
+	AuthMode  types.String    `tfsdk:"auth_mode"`
+	Category  types.String    `tfsdk:"category"`
+	Providers []providerModel `tfsdk:"providers"`
+}
+
+// NewProvidersDataSource is a helper function to simplify the provider implementation.
+func NewProvidersDataSource() datasource.DataSource {
+	return &providersDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *providersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_providers"
+}
+
+// Schema defines the schema for the data source.
+func (d *providersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the providers of the Nango catalog, the valid values of `nango_provider`.",
+		Attributes: map[string]schema.Attribute{
+			"auth_mode": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return providers with this auth mode, for example `OAUTH2`.",
+			},
+			"category": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return providers in this catalog category, for example `crm`.",
+			},
+			"providers": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: providerAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *providersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state providersDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	providers, err := d.client.ListProviders(ctx)
+	if err != nil {
+		addNangoError(&resp.Diagnostics, "Unable to Read Providers", err, nil)
+		return
+	}
+
+	state.Providers = []providerModel{}
+	for i := range providers {
+		provider := &providers[i]
+		if !state.AuthMode.IsNull() && provider.AuthMode != state.AuthMode.ValueString() {
+			continue
+		}
+		if !state.Category.IsNull() && !slices.Contains(provider.Categories, state.Category.ValueString()) {
+			continue
+		}
+		state.Providers = append(state.Providers, newProviderModel(provider))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *providersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*nango.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *nango.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,510 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-nango/internal/nango"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &syncScheduleResource{}
+	_ resource.ResourceWithConfigure   = &syncScheduleResource{}
+	_ resource.ResourceWithImportState = &syncScheduleResource{}
+)
+
+// NewSyncScheduleResource is a helper function to simplify the provider implementation.
+func NewSyncScheduleResource() resource.Resource {
+	return &syncScheduleResource{}
+}
+
+// syncScheduleResourceModel maps the nango_sync_schedule resource schema data.
+type syncScheduleResourceModel struct {
+	ProviderConfigKey types.String `tfsdk:"provider_config_key"`
+	ConnectionId      types.String `tfsdk:"connection_id"`
+	Syncs             types.Set    `tfsdk:"syncs"`
+	Paused            types.Bool   `tfsdk:"paused"`
+	Frequency         types.String `tfsdk:"frequency"`
+	Trigger           types.String `tfsdk:"trigger"`
+	FullResync        types.Bool   `tfsdk:"full_resync"`
+}
+
+// syncScheduleResource is the resource implementation.
+type syncScheduleResource struct {
+	client *nango.Client
+}
+
+// Metadata returns the resource type name.
+func (r *syncScheduleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sync_schedule"
+}
+
+// Schema defines the schema for the resource.
+func (r *syncScheduleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Controls whether syncs of a Nango connection are running or paused, and optionally overrides their frequency. Destroying the resource removes the frequency override but leaves the syncs running or paused as they are.",
+		Attributes: map[string]schema.Attribute{
+			"provider_config_key": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The `unique_key` of the integration the syncs belong to. Changing this forces a new resource to be created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"connection_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The connection whose syncs are controlled. Changing this forces a new resource to be created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"syncs": schema.SetAttribute{
+				Required:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The names of the syncs to control.",
+			},
+			"paused": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether the syncs are paused. Defaults to `false`, which starts them.",
+			},
+			"frequency": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Overrides how often the syncs run for this connection, for example `every 30 minutes`. When unset, the frequency of the sync script applies.",
+			},
+			"trigger": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "An arbitrary value. Whenever it changes, the syncs are run once outside of their schedule.",
+			},
+			"full_resync": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Whether runs started through `trigger` discard the sync checkpoint and fetch all records again.",
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *syncScheduleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan syncScheduleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	syncs, diags := syncNames(ctx, plan.Syncs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.setPaused(ctx, plan, syncs)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !plan.Frequency.IsNull() {
+		resp.Diagnostics.Append(r.setFrequency(ctx, plan, syncs, plan.Frequency.ValueStringPointer())...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	if !plan.Trigger.IsNull() {
+		resp.Diagnostics.Append(r.trigger(ctx, plan, syncs)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *syncScheduleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state syncScheduleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, diags := r.refresh(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *syncScheduleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state syncScheduleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	syncs, diags := syncNames(ctx, plan.Syncs)
+	resp.Diagnostics.Append(diags...)
+	priorSyncs, priorDiags := syncNames(ctx, state.Syncs)
+	resp.Diagnostics.Append(priorDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Syncs no longer controlled here lose their frequency override.
+	if !state.Frequency.IsNull() {
+		var removed []string
+		for _, name := range priorSyncs {
+			if !slices.Contains(syncs, name) {
+				removed = append(removed, name)
+			}
+		}
+		resp.Diagnostics.Append(r.setFrequency(ctx, state, removed, nil)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !plan.Paused.Equal(state.Paused) || !plan.Syncs.Equal(state.Syncs) {
+		resp.Diagnostics.Append(r.setPaused(ctx, plan, syncs)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	if !plan.Frequency.Equal(state.Frequency) || !plan.Syncs.Equal(state.Syncs) {
+		if !plan.Frequency.IsNull() || !state.Frequency.IsNull() {
+			resp.Diagnostics.Append(r.setFrequency(ctx, plan, syncs, plan.Frequency.ValueStringPointer())...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+	if !plan.Trigger.IsNull() && !plan.Trigger.Equal(state.Trigger) {
+		resp.Diagnostics.Append(r.trigger(ctx, plan, syncs)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes the frequency override. The syncs keep running or stay
+// paused.
+func (r *syncScheduleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state syncScheduleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || state.Frequency.IsNull() {
+		return
+	}
+
+	syncs, diags := syncNames(ctx, state.Syncs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, name := range syncs {
+		_, err := r.client.UpdateSyncFrequency(ctx, nango.UpdateSyncFrequencyRequest{
+			SyncName:          name,
+			ProviderConfigKey: state.ProviderConfigKey.ValueString(),
+			ConnectionID:      state.ConnectionId.ValueString(),
+		})
+		// Already gone along with the connection; nothing left to reset.
+		if err != nil && !nango.IsNotFound(err) {
+			addNangoError(&resp.Diagnostics, "Unable to Reset Frequency of Sync "+name, err, nil)
+			return
+		}
+	}
+}
+
+// refresh updates model from the status of its syncs. Syncs that no longer
+// exist are dropped from syncs; it returns false when none are left, or
+// when the connection or integration is gone.
+func (r *syncScheduleResource) refresh(ctx context.Context, model *syncScheduleResourceModel) (bool, diag.Diagnostics) {
+	syncs, diags := syncNames(ctx, model.Syncs)
+	if diags.HasError() {
+		return false, diags
+	}
+
+	statuses, err := r.client.GetSyncStatus(ctx, model.ProviderConfigKey.ValueString(), model.ConnectionId.ValueString(), syncs)
+	if nango.IsNotFound(err) {
+		return false, diags
+	}
+	if err != nil {
+		addNangoError(&diags, "Unable to Read Sync Status", err, nil)
+		return false, diags
+	}
+
+	var existing []string
+	for _, status := range statuses {
+		if slices.Contains(syncs, status.Name) && !slices.Contains(existing, status.Name) {
+			existing = append(existing, status.Name)
+		}
+	}
+	if len(existing) == 0 {
+		return false, diags
+	}
+	if len(existing) != len(syncs) {
+		var setDiags diag.Diagnostics
+		model.Syncs, setDiags = types.SetValueFrom(ctx, types.StringType, existing)
+		diags.Append(setDiags...)
+	}
+
+	// Report drift as soon as one sync disagrees with the configured state:
+	// a paused schedule needs every sync paused, a running one none.
+	paused := model.Paused.ValueBool()
+	for _, status := range statuses {
+		if (status.Status == nango.SyncStatusPaused) != model.Paused.ValueBool() {
+			paused = !model.Paused.ValueBool()
+			break
+		}
+	}
+	model.Paused = types.BoolValue(paused)
+
+	// Without an override the syncs run at the frequency of their script,
+	// which this resource does not manage.
+	if !model.Frequency.IsNull() {
+		for _, status := range statuses {
+			if status.Frequency != "" && !sameFrequency(status.Frequency, model.Frequency.ValueString()) {
+				model.Frequency = types.StringValue(status.Frequency)
+				break
+			}
+		}
+	}
+	return true, diags
+}
+
+// sameFrequency reports whether two sync frequencies describe the same
+// interval. Nango normalizes the configured value, for example from
+// "every 30m" to "every 30 minutes", so they are compared as durations
+// when both parse.
+func sameFrequency(a, b string) bool {
+	if a == b {
+		return true
+	}
+	durationA, okA := parseFrequency(a)
+	durationB, okB := parseFrequency(b)
+	return okA && okB && durationA == durationB
+}
+
+// frequencyUnits maps the units Nango accepts in a frequency to their
+// duration.
+var frequencyUnits = map[string]time.Duration{
+	"s": time.Second, "sec": time.Second, "secs": time.Second, "second": time.Second, "seconds": time.Second,
+	"m": time.Minute, "min": time.Minute, "mins": time.Minute, "minute": time.Minute, "minutes": time.Minute,
+	"h": time.Hour, "hr": time.Hour, "hrs": time.Hour, "hour": time.Hour, "hours": time.Hour,
+	"d": 24 * time.Hour, "day": 24 * time.Hour, "days": 24 * time.Hour,
+	"w": 7 * 24 * time.Hour, "week": 7 * 24 * time.Hour, "weeks": 7 * 24 * time.Hour,
+}
+
+// parseFrequency parses frequencies such as "every 30 minutes", "every 2h"
+// or "every day".
+func parseFrequency(frequency string) (time.Duration, bool) {
+	value := strings.TrimSpace(strings.ToLower(frequency))
+	value = strings.TrimSpace(strings.TrimPrefix(value, "every"))
+
+	number := strings.TrimRightFunc(value, func(r rune) bool { return r < '0' || r > '9' })
+	unit := strings.TrimSpace(strings.TrimPrefix(value, number))
+	count := 1
+	if number != "" {
+		var err error
+		if count, err = strconv.Atoi(strings.TrimSpace(number)); err != nil {
+			return 0, false
+		}
+	}
+
+	duration, ok := frequencyUnits[unit]
+	if !ok {
+		return 0, false
+	}
+	return time.Duration(count) * duration, true
+}
+
+// setPaused starts or pauses the syncs to match the plan.
+func (r *syncScheduleResource) setPaused(ctx context.Context, plan syncScheduleResourceModel, syncs []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	request := nango.SyncsRequest{
+		ProviderConfigKey: plan.ProviderConfigKey.ValueString(),
+		ConnectionID:      plan.ConnectionId.ValueString(),
+		Syncs:             syncs,
+	}
+	if plan.Paused.ValueBool() {
+		if err := r.client.PauseSyncs(ctx, request); err != nil {
+			addNangoError(&diags, "Unable to Pause Syncs", err, syncScheduleAttributePath)
+		}
+		return diags
+	}
+	if err := r.client.StartSyncs(ctx, request); err != nil {
+		addNangoError(&diags, "Unable to Start Syncs", err, syncScheduleAttributePath)
+	}
+	return diags
+}
+
+// setFrequency overrides the frequency of each sync. A nil frequency
+// resets it to the frequency of the sync script.
+func (r *syncScheduleResource) setFrequency(ctx context.Context, model syncScheduleResourceModel, syncs []string, frequency *string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, name := range syncs {
+		_, err := r.client.UpdateSyncFrequency(ctx, nango.UpdateSyncFrequencyRequest{
+			SyncName:          name,
+			ProviderConfigKey: model.ProviderConfigKey.ValueString(),
+			ConnectionID:      model.ConnectionId.ValueString(),
+			Frequency:         frequency,
+		})
+		if err != nil {
+			addNangoError(&diags, "Unable to Update Frequency of Sync "+name, err, syncScheduleAttributePath)
+			return diags
+		}
+	}
+	return diags
+}
+
+// trigger runs the syncs once.
+func (r *syncScheduleResource) trigger(ctx context.Context, plan syncScheduleResourceModel, syncs []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	err := r.client.TriggerSyncs(ctx, nango.SyncsRequest{
+		ProviderConfigKey: plan.ProviderConfigKey.ValueString(),
+		ConnectionID:      plan.ConnectionId.ValueString(),
+		Syncs:             syncs,
+		FullResync:        plan.FullResync.ValueBool(),
+	})
+	if err != nil {
+		addNangoError(&diags, "Unable to Trigger Syncs", err, syncScheduleAttributePath)
+	}
+	return diags
+}
+
+// syncNames returns the sync names of a set attribute.
+func syncNames(ctx context.Context, set types.Set) ([]string, diag.Diagnostics) {
+	syncs := []string{}
+	diags := set.ElementsAs(ctx, &syncs, false)
+	return syncs, diags
+}
+
+// syncScheduleAttributePath maps fields of the sync request bodies to their
+// schema attributes.
+func syncScheduleAttributePath(field []string) (path.Path, bool) {
+	if len(field) == 0 {
+		return path.Empty(), false
+	}
+
+	switch field[0] {
+	case "provider_config_key", "connection_id", "syncs", "frequency", "full_resync":
+		return path.Root(field[0]), true
+	case "sync_name":
+		return path.Root("syncs"), true
+	}
+	return path.Empty(), false
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *syncScheduleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*nango.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *nango.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ImportState imports the resource into Terraform state. The frequency is
+// left unset, since an override cannot be told apart from the frequency of
+// the sync script.
+func (r *syncScheduleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// The import ID is <provider_config_key>/<connection_id>/<sync>[,<sync>...]
+	providerConfigKey, rest, _ := strings.Cut(req.ID, "/")
+	separator := strings.LastIndex(rest, "/")
+	var connectionID, names string
+	if separator >= 0 {
+		connectionID, names = rest[:separator], rest[separator+1:]
+	}
+
+	var syncs []string
+	for _, name := range strings.Split(names, ",") {
+		if name = strings.TrimSpace(name); name != "" && !slices.Contains(syncs, name) {
+			syncs = append(syncs, name)
+		}
+	}
+	if providerConfigKey == "" || connectionID == "" || len(syncs) == 0 {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			"Expected an import ID of the form <provider_config_key>/<connection_id>/<sync>[,<sync>...], got: "+req.ID,
+		)
+		return
+	}
+
+	syncSet, diags := types.SetValueFrom(ctx, types.StringType, syncs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := syncScheduleResourceModel{
+		ProviderConfigKey: types.StringValue(providerConfigKey),
+		ConnectionId:      types.StringValue(connectionID),
+		Syncs:             syncSet,
+		Paused:            types.BoolValue(false),
+		Frequency:         types.StringNull(),
+		Trigger:           types.StringNull(),
+		FullResync:        types.BoolNull(),
+	}
+	found, diags := r.refresh(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddError(
+			"Syncs Not Found",
+			"None of the syncs "+names+" exist for connection "+connectionID+" of integration "+providerConfigKey+".",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
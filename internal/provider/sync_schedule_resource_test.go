@@ -0,0 +1,32 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import "testing"
+
+func TestSameFrequency(t *testing.T) {
+	tests := map[string]struct {
+		a, b string
+		want bool
+	}{
+		"identical":         {a: "every 30 minutes", b: "every 30 minutes", want: true},
+		"abbreviated unit":  {a: "every 30m", b: "every 30 minutes", want: true},
+		"implicit one":      {a: "every hour", b: "every 1h", want: true},
+		"converted unit":    {a: "every 60 minutes", b: "every hour", want: true},
+		"days":              {a: "every day", b: "every 24 hours", want: true},
+		"case and spacing":  {a: "Every  2 Hours", b: "every 2h", want: true},
+		"different":         {a: "every 30 minutes", b: "every hour"},
+		"unparsable":        {a: "every blue moon", b: "every 30 minutes"},
+		"both unparsable":   {a: "weekdays at noon", b: "weekdays at 12"},
+		"unparsable number": {a: "every 3x minutes", b: "every 3 minutes"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := sameFrequency(tt.a, tt.b); got != tt.want {
+				t.Errorf("sameFrequency(%q, %q) = %t, want %t", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,212 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-nango/internal/nango"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &syncStatusDataSource{}
+	_ datasource.DataSourceWithConfigure = &syncStatusDataSource{}
+)
+
+type syncStatusDataSource struct {
+	client *nango.Client
+}
+
+type syncStatusDataSourceModel struct {
+	ProviderConfigKey types.String      `tfsdk:"provider_config_key"`
+	ConnectionId      types.String      `tfsdk:"connection_id"`
+	Syncs             []types.String    `tfsdk:"syncs"`
+	Statuses          []syncStatusModel `tfsdk:"statuses"`
+}
+
+type syncStatusModel struct {
+	Name         types.String                    `tfsdk:"name"`
+	ConnectionId types.String                    `tfsdk:"connection_id"`
+	Status       types.String                    `tfsdk:"status"`
+	Type         types.String                    `tfsdk:"type"`
+	Frequency    types.String                    `tfsdk:"frequency"`
+	LastRunAt    types.String                    `tfsdk:"last_run_at"`
+	NextRunAt    types.String                    `tfsdk:"next_run_at"`
+	RecordCounts map[string]types.Int64          `tfsdk:"record_counts"`
+	LatestResult map[string]syncModelResultModel `tfsdk:"latest_result"`
+}
+
+type syncModelResultModel struct {
+	Added   types.Int64 `tfsdk:"added"`
+	Updated types.Int64 `tfsdk:"updated"`
+	Deleted types.Int64 `tfsdk:"deleted"`
+}
+
+// NewSyncStatusDataSource is a helper function to simplify the provider implementation.
+func NewSyncStatusDataSource() datasource.DataSource {
+	return &syncStatusDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *syncStatusDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sync_status"
+}
+
+// Schema defines the schema for the data source.
+func (d *syncStatusDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reports the status of the syncs of a Nango integration, one entry per sync and connection.",
+		Attributes: map[string]schema.Attribute{
+			"provider_config_key": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The `unique_key` of the integration the syncs belong to.",
+			},
+			"connection_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only report the syncs of this connection.",
+			},
+			"syncs": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The names of the syncs to report. Defaults to all syncs of the integration.",
+			},
+			"statuses": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The sync name",
+						},
+						"connection_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The connection the sync runs for",
+						},
+						"status": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "One of `RUNNING`, `SUCCESS`, `ERROR`, `PAUSED` or `STOPPED`",
+						},
+						"type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the last run was `INCREMENTAL` or `FULL`",
+						},
+						"frequency": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "How often the sync runs",
+						},
+						"last_run_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "When the last run finished",
+						},
+						"next_run_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "When the next run is scheduled",
+						},
+						"record_counts": schema.MapAttribute{
+							Computed:            true,
+							ElementType:         types.Int64Type,
+							MarkdownDescription: "The number of records stored, by model",
+						},
+						"latest_result": schema.MapNestedAttribute{
+							Computed:            true,
+							MarkdownDescription: "The records the last run changed, by model",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"added": schema.Int64Attribute{
+										Computed:            true,
+										MarkdownDescription: "Records added",
+									},
+									"updated": schema.Int64Attribute{
+										Computed:            true,
+										MarkdownDescription: "Records updated",
+									},
+									"deleted": schema.Int64Attribute{
+										Computed:            true,
+										MarkdownDescription: "Records deleted",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *syncStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state syncStatusDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var syncs []string
+	for _, name := range state.Syncs {
+		syncs = append(syncs, name.ValueString())
+	}
+
+	statuses, err := d.client.GetSyncStatus(ctx, state.ProviderConfigKey.ValueString(), state.ConnectionId.ValueString(), syncs)
+	if err != nil {
+		addNangoError(&resp.Diagnostics, "Unable to Read Sync Status", err, nil)
+		return
+	}
+
+	state.Statuses = []syncStatusModel{}
+	for _, status := range statuses {
+		statusState := syncStatusModel{
+			Name:         types.StringValue(status.Name),
+			ConnectionId: types.StringValue(status.ConnectionID),
+			Status:       types.StringValue(status.Status),
+			Type:         optionalString(status.Type),
+			Frequency:    optionalString(status.Frequency),
+			LastRunAt:    optionalString(status.FinishedAt),
+			NextRunAt:    optionalString(status.NextScheduledSyncAt),
+			RecordCounts: map[string]types.Int64{},
+			LatestResult: map[string]syncModelResultModel{},
+		}
+		for model, count := range status.RecordCount {
+			statusState.RecordCounts[model] = types.Int64Value(count)
+		}
+		for model, result := range status.LatestResult {
+			statusState.LatestResult[model] = syncModelResultModel{
+				Added:   types.Int64Value(result.Added),
+				Updated: types.Int64Value(result.Updated),
+				Deleted: types.Int64Value(result.Deleted),
+			}
+		}
+
+		state.Statuses = append(state.Statuses, statusState)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *syncStatusDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*nango.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *nango.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}